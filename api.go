@@ -70,6 +70,10 @@ type FileMatch struct {
 	// Detected language of the result.
 	Language string
 
+	// Encoding is the encoding of the original file, if it was transcoded to
+	// UTF-8 for indexing. Content and all offsets refer to the UTF-8 content.
+	Encoding string
+
 	// SubRepositoryName is the globally unique name of the repo,
 	// if it came from a subrepository
 	SubRepositoryName string
@@ -80,20 +84,33 @@ type FileMatch struct {
 
 	// Commit SHA1 (hex) of the (sub)repo holding the file.
 	Version string
+
+	// Commit is true if the match is a commit document returned for a
+	// type:commit query. FileName and Version are then the commit hash.
+	Commit bool
+
+	// Author is the author of the last commit touching the file, formatted
+	// as "Name <email>", if it was recorded while indexing.
+	Author string
 }
 
 func (m *FileMatch) sizeBytes() (sz uint64) {
 	// Score
 	sz += 8
 
+	// Commit
+	sz += 1
+
 	for _, s := range []string{
 		m.Debug,
 		m.FileName,
 		m.Repository,
 		m.Language,
+		m.Encoding,
 		m.SubRepositoryName,
 		m.SubRepositoryPath,
 		m.Version,
+		m.Author,
 	} {
 		sz += stringHeaderBytes + uint64(len(s))
 	}
@@ -401,10 +418,13 @@ type Stats struct {
 
 	// FlushReason explains why results were flushed.
 	FlushReason FlushReason
+
+	// CacheHits is the number of searches answered from the result cache.
+	CacheHits int
 }
 
 func (s *Stats) sizeBytes() (sz uint64) {
-	sz = 16 * 8 // This assumes we are running on a 64-bit architecture
+	sz = 17 * 8 // This assumes we are running on a 64-bit architecture
 	sz += 1     // FlushReason
 
 	return
@@ -429,6 +449,7 @@ func (s *Stats) Add(o Stats) {
 	s.MatchTreeConstruction += o.MatchTreeConstruction
 	s.MatchTreeSearch += o.MatchTreeSearch
 	s.RegexpsConsidered += o.RegexpsConsidered
+	s.CacheHits += o.CacheHits
 
 	// We want the first non-zero FlushReason to be sticky. This is a useful
 	// property when aggregating stats from several Zoekts.
@@ -460,7 +481,8 @@ func (s *Stats) Zero() bool {
 		s.Wait > 0 ||
 		s.MatchTreeConstruction > 0 ||
 		s.MatchTreeSearch > 0 ||
-		s.RegexpsConsidered > 0)
+		s.RegexpsConsidered > 0 ||
+		s.CacheHits > 0)
 }
 
 // Progress contains information about the global progress of the running search query.
@@ -491,14 +513,77 @@ type SearchResult struct {
 
 	Files []FileMatch
 
-	// RepoURLs holds a repo => template string map.
+	// RepoURLs holds a repo => template string map. For type:commit queries
+	// it holds the commit URL templates, see Repository.CommitURLTemplate.
 	RepoURLs map[string]string
 
 	// FragmentNames holds a repo => template string map, for
 	// the line number fragment.
 	LineFragments map[string]string
+
+	// BackendErrors lists the backends of a federated search which failed
+	// or timed out. The results of the other backends are still returned.
+	BackendErrors []BackendError `json:",omitempty"`
+
+	// IncompleteShards lists the shards whose results are missing or may be
+	// incomplete because their search crashed or timed out.
+	IncompleteShards []IncompleteShard `json:",omitempty"`
+}
+
+// IncompleteRepos returns the names of the repositories whose results may be
+// incomplete, see IncompleteShards.
+func (sr *SearchResult) IncompleteRepos() []string {
+	var repos []string
+	seen := map[string]bool{}
+	for _, s := range sr.IncompleteShards {
+		for _, r := range s.Repos {
+			if !seen[r] {
+				seen[r] = true
+				repos = append(repos, r)
+			}
+		}
+	}
+	return repos
+}
+
+// BackendError describes a backend of a federated search which did not
+// contribute to a SearchResult.
+type BackendError struct {
+	// Backend is the address of the backend.
+	Backend string
+
+	// Error is the error returned by the backend.
+	Error string
+
+	// Timeout is true if the backend did not respond in time.
+	Timeout bool
 }
 
+// IncompleteShard describes a shard whose results are missing or may be
+// incomplete.
+type IncompleteShard struct {
+	// Shard describes the shard, usually its file name.
+	Shard string
+
+	// Repos are the names of the repositories in the shard.
+	Repos []string
+
+	// Reason is why the results are incomplete.
+	Reason IncompleteReason
+}
+
+// IncompleteReason is why the results of a shard are incomplete.
+type IncompleteReason string
+
+const (
+	// IncompleteCrashed means the search of the shard panicked.
+	IncompleteCrashed IncompleteReason = "crashed"
+
+	// IncompleteTimeout means the search timed out before or while searching
+	// the shard.
+	IncompleteTimeout IncompleteReason = "timeout"
+)
+
 // SizeBytes is a best-effort estimate of the size of SearchResult in memory.
 // The estimate does not take alignment into account. The result is a lower
 // bound on the actual size in memory.
@@ -526,6 +611,25 @@ func (sr *SearchResult) SizeBytes() (sz uint64) {
 		sz += stringHeaderBytes + uint64(len(v))
 	}
 
+	// BackendErrors
+	sz += sliceHeaderBytes
+	for _, e := range sr.BackendErrors {
+		sz += stringHeaderBytes + uint64(len(e.Backend))
+		sz += stringHeaderBytes + uint64(len(e.Error))
+		sz += 1
+	}
+
+	// IncompleteShards
+	sz += sliceHeaderBytes
+	for _, s := range sr.IncompleteShards {
+		sz += stringHeaderBytes + uint64(len(s.Shard))
+		sz += sliceHeaderBytes
+		for _, r := range s.Repos {
+			sz += stringHeaderBytes + uint64(len(r))
+		}
+		sz += stringHeaderBytes + uint64(len(s.Reason))
+	}
+
 	return
 }
 
@@ -47,9 +47,12 @@ func FileMatchFromProto(p *proto.FileMatch) FileMatch {
 		Content:            p.GetContent(),
 		Checksum:           p.GetChecksum(),
 		Language:           p.GetLanguage(),
+		Encoding:           p.GetEncoding(),
 		SubRepositoryName:  p.GetSubRepositoryName(),
 		SubRepositoryPath:  p.GetSubRepositoryPath(),
 		Version:            p.GetVersion(),
+		Commit:             p.GetCommit(),
+		Author:             p.GetAuthor(),
 	}
 }
 
@@ -77,9 +80,12 @@ func (m *FileMatch) ToProto() *proto.FileMatch {
 		Content:            m.Content,
 		Checksum:           m.Checksum,
 		Language:           m.Language,
+		Encoding:           m.Encoding,
 		SubRepositoryName:  m.SubRepositoryName,
 		SubRepositoryPath:  m.SubRepositoryPath,
 		Version:            m.Version,
+		Commit:             m.Commit,
+		Author:             m.Author,
 	}
 }
 
@@ -303,6 +309,7 @@ func StatsFromProto(p *proto.Stats) Stats {
 		MatchTreeSearch:       p.GetMatchTreeSearch().AsDuration(),
 		RegexpsConsidered:     int(p.GetRegexpsConsidered()),
 		FlushReason:           FlushReasonFromProto(p.GetFlushReason()),
+		CacheHits:             int(p.GetCacheHits()),
 	}
 }
 
@@ -328,6 +335,7 @@ func (s *Stats) ToProto() *proto.Stats {
 		MatchTreeSearch:       durationpb.New(s.MatchTreeSearch),
 		RegexpsConsidered:     int64(s.RegexpsConsidered),
 		FlushReason:           s.FlushReason.ToProto(),
+		CacheHits:             int64(s.CacheHits),
 	}
 }
 
@@ -363,6 +371,16 @@ func SearchResultFromProto(p *proto.SearchResponse, repoURLs, lineFragments map[
 		files[i] = FileMatchFromProto(file)
 	}
 
+	backendErrors := make([]BackendError, len(p.GetBackendErrors()))
+	for i, e := range p.GetBackendErrors() {
+		backendErrors[i] = BackendErrorFromProto(e)
+	}
+
+	incompleteShards := make([]IncompleteShard, len(p.GetIncompleteShards()))
+	for i, s := range p.GetIncompleteShards() {
+		incompleteShards[i] = IncompleteShardFromProto(s)
+	}
+
 	return &SearchResult{
 		Stats:    StatsFromProto(p.GetStats()),
 		Progress: ProgressFromProto(p.GetProgress()),
@@ -371,6 +389,9 @@ func SearchResultFromProto(p *proto.SearchResponse, repoURLs, lineFragments map[
 
 		RepoURLs:      repoURLs,
 		LineFragments: lineFragments,
+
+		BackendErrors:    backendErrors,
+		IncompleteShards: incompleteShards,
 	}
 }
 
@@ -384,11 +405,58 @@ func (sr *SearchResult) ToProto() *proto.SearchResponse {
 		files[i] = file.ToProto()
 	}
 
+	backendErrors := make([]*proto.BackendError, len(sr.BackendErrors))
+	for i, e := range sr.BackendErrors {
+		backendErrors[i] = e.ToProto()
+	}
+
+	incompleteShards := make([]*proto.IncompleteShard, len(sr.IncompleteShards))
+	for i, s := range sr.IncompleteShards {
+		incompleteShards[i] = s.ToProto()
+	}
+
 	return &proto.SearchResponse{
 		Stats:    sr.Stats.ToProto(),
 		Progress: sr.Progress.ToProto(),
 
 		Files: files,
+
+		BackendErrors:    backendErrors,
+		IncompleteShards: incompleteShards,
+	}
+}
+
+func BackendErrorFromProto(p *proto.BackendError) BackendError {
+	return BackendError{
+		Backend: p.GetBackend(),
+		Error:   p.GetError(),
+		Timeout: p.GetTimeout(),
+	}
+}
+
+func (e *BackendError) ToProto() *proto.BackendError {
+	return &proto.BackendError{
+		Backend: e.Backend,
+		Error:   e.Error,
+		Timeout: e.Timeout,
+	}
+}
+
+func IncompleteShardFromProto(p *proto.IncompleteShard) IncompleteShard {
+	repos := make([]string, len(p.GetRepos()))
+	copy(repos, p.GetRepos())
+	return IncompleteShard{
+		Shard:  p.GetShard(),
+		Repos:  repos,
+		Reason: IncompleteReason(p.GetReason()),
+	}
+}
+
+func (s *IncompleteShard) ToProto() *proto.IncompleteShard {
+	return &proto.IncompleteShard{
+		Shard:  s.Shard,
+		Repos:  s.Repos,
+		Reason: string(s.Reason),
 	}
 }
 
@@ -83,9 +83,9 @@ func benchmarkEncoding(data interface{}) func(*testing.B) {
 
 func TestSizeBytesSearchResult(t *testing.T) {
 	var sr = SearchResult{
-		Stats:    Stats{},    // 129 bytes
+		Stats:    Stats{},    // 137 bytes
 		Progress: Progress{}, // 16 bytes
-		Files: []FileMatch{{ // 24 bytes + 460 bytes
+		Files: []FileMatch{{ // 24 bytes + 476 bytes
 			Score:       0,   // 8 bytes
 			Debug:       "",  // 16 bytes
 			FileName:    "",  // 16 bytes
@@ -101,20 +101,25 @@ func TestSizeBytesSearchResult(t *testing.T) {
 				Score:        0,
 				DebugScore:   "",
 			}},
-			RepositoryID:       0,   // 4 bytes
-			RepositoryPriority: 0,   // 8 bytes
-			Content:            nil, // 24 bytes
-			Checksum:           nil, // 24 bytes
-			Language:           "",  // 16 bytes
-			SubRepositoryName:  "",  // 16 bytes
-			SubRepositoryPath:  "",  // 16 bytes
-			Version:            "",  // 16 bytes
+			RepositoryID:       0,     // 4 bytes
+			RepositoryPriority: 0,     // 8 bytes
+			Content:            nil,   // 24 bytes
+			Checksum:           nil,   // 24 bytes
+			Language:           "",    // 16 bytes
+			Encoding:           "",    // 16 bytes
+			SubRepositoryName:  "",    // 16 bytes
+			SubRepositoryPath:  "",    // 16 bytes
+			Version:            "",    // 16 bytes
+			Commit:             false, // 1 byte
+			Author:             "",    // 16 bytes
 		}},
-		RepoURLs:      nil, // 48 bytes
-		LineFragments: nil, // 48 bytes
+		RepoURLs:         nil, // 48 bytes
+		LineFragments:    nil, // 48 bytes
+		BackendErrors:    nil, // 24 bytes
+		IncompleteShards: nil, // 24 bytes
 	}
 
-	var wantBytes uint64 = 725
+	var wantBytes uint64 = 814
 	if sr.SizeBytes() != wantBytes {
 		t.Fatalf("want %d, got %d", wantBytes, sr.SizeBytes())
 	}
@@ -0,0 +1,77 @@
+// Package authz restricts searches to the repositories the principal of a
+// request may access.
+//
+// The principal is carried through the context of a request, see
+// WithPrincipal. Servers embedding zoekt authenticate their requests, set the
+// principal and configure an Authorizer on the searcher, which then only
+// searches and lists repositories allowed by the Filter of the principal.
+package authz
+
+import (
+	"context"
+
+	"github.com/RoaringBitmap/roaring"
+
+	"github.com/sourcegraph/zoekt"
+)
+
+// Principal is who a request is performed for.
+type Principal struct {
+	// ID identifies the principal to the Authorizer, e.g. a user name.
+	ID string
+}
+
+type principalKey struct{}
+
+// WithPrincipal returns a context for requests performed for p.
+func WithPrincipal(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// PrincipalFromContext returns the principal of ctx, or nil if the request is
+// anonymous.
+func PrincipalFromContext(ctx context.Context) *Principal {
+	p, _ := ctx.Value(principalKey{}).(*Principal)
+	return p
+}
+
+// Authorizer decides which repositories a principal may access.
+type Authorizer interface {
+	// Authorize returns the filter for requests performed for p, which is
+	// nil for anonymous requests. A nil filter allows all repositories. An
+	// error fails the request.
+	Authorize(ctx context.Context, p *Principal) (*Filter, error)
+}
+
+// AuthorizerFunc is an adapter to use a function as an Authorizer.
+type AuthorizerFunc func(ctx context.Context, p *Principal) (*Filter, error)
+
+// Authorize implements Authorizer.
+func (f AuthorizerFunc) Authorize(ctx context.Context, p *Principal) (*Filter, error) {
+	return f(ctx, p)
+}
+
+// Filter describes the repositories a principal may access. If both fields
+// are set a repository has to pass both.
+type Filter struct {
+	// RepoIDs if non-nil are the IDs of the allowed repositories.
+	RepoIDs *roaring.Bitmap
+
+	// Predicate if non-nil returns true for allowed repositories. It is
+	// called concurrently.
+	Predicate func(*zoekt.Repository) bool
+}
+
+// Allow returns true if f allows access to repo.
+func (f *Filter) Allow(repo *zoekt.Repository) bool {
+	if f == nil {
+		return true
+	}
+	if f.RepoIDs != nil && !f.RepoIDs.Contains(repo.ID) {
+		return false
+	}
+	if f.Predicate != nil && !f.Predicate(repo) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,158 @@
+package zoekt
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+// branchSet is a variable-width bitmap of branches. Bit i%64 of word i/64
+// is set if branch i of the repository is in the set, where branches are
+// numbered in the order of Repository.Branches. By convention branch 0 is
+// the default branch.
+//
+// Shards store the first word of each document in the branchMasks section,
+// and the remaining words, if any, in the branchMasksHigh section. This
+// keeps shards of repositories with at most 64 branches unchanged.
+type branchSet []uint64
+
+// add adds branch i to the set.
+func (s *branchSet) add(i int) {
+	for len(*s) <= i/64 {
+		*s = append(*s, 0)
+	}
+	(*s)[i/64] |= 1 << uint(i%64)
+}
+
+// empty returns true if no branch is in the set.
+func (s branchSet) empty() bool {
+	for _, w := range s {
+		if w != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// count returns the number of branches in the set.
+func (s branchSet) count() int {
+	n := 0
+	for _, w := range s {
+		n += bits.OnesCount64(w)
+	}
+	return n
+}
+
+// intersects returns true if s and o have a branch in common.
+func (s branchSet) intersects(o branchSet) bool {
+	for i := 0; i < len(s) && i < len(o); i++ {
+		if s[i]&o[i] != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// and returns the intersection of s and o.
+func (s branchSet) and(o branchSet) branchSet {
+	n := len(s)
+	if len(o) < n {
+		n = len(o)
+	}
+	r := make(branchSet, n)
+	for i := range r {
+		r[i] = s[i] & o[i]
+	}
+	return r
+}
+
+// or returns the union of s and o.
+func (s branchSet) or(o branchSet) branchSet {
+	if len(s) < len(o) {
+		s, o = o, s
+	}
+	r := append(branchSet(nil), s...)
+	for i, w := range o {
+		r[i] |= w
+	}
+	return r
+}
+
+// ids returns the branches in the set in increasing order.
+func (s branchSet) ids() []int {
+	var ids []int
+	for i, w := range s {
+		for w != 0 {
+			b := bits.TrailingZeros64(w)
+			ids = append(ids, i*64+b)
+			w &^= 1 << uint(b)
+		}
+	}
+	return ids
+}
+
+// first returns the lowest branch in the set, or -1 if the set is empty.
+func (s branchSet) first() int {
+	for i, w := range s {
+		if w != 0 {
+			return i*64 + bits.TrailingZeros64(w)
+		}
+	}
+	return -1
+}
+
+// marshalHigh encodes the words after the first one for the branchMasksHigh
+// section. Trailing zero words are left out.
+func (s branchSet) marshalHigh() []byte {
+	n := len(s)
+	for n > 1 && s[n-1] == 0 {
+		n--
+	}
+	if n <= 1 {
+		return nil
+	}
+	blob := make([]byte, 8*(n-1))
+	for i, w := range s[1:n] {
+		binary.BigEndian.PutUint64(blob[8*i:], w)
+	}
+	return blob
+}
+
+// unmarshalBranchMasksHigh decodes an item of the branchMasksHigh section.
+func unmarshalBranchMasksHigh(blob []byte) []uint64 {
+	if len(blob) == 0 {
+		return nil
+	}
+	words := make([]uint64, 0, len(blob)/8)
+	for len(blob) >= 8 {
+		words = append(words, binary.BigEndian.Uint64(blob))
+		blob = blob[8:]
+	}
+	return words
+}
+
+// fileBranches returns the branches of document docID.
+func (d *indexData) fileBranches(docID uint32) branchSet {
+	return append(branchSet{d.fileBranchMasks[docID]}, d.fileBranchesHigh(docID)...)
+}
+
+// fileBranchesHigh returns the words after the first one of the branches of
+// document docID.
+func (d *indexData) fileBranchesHigh(docID uint32) []uint64 {
+	if d.fileBranchMasksHigh == nil {
+		return nil
+	}
+	return d.fileBranchMasksHigh[docID]
+}
+
+// inBranches returns true if a document with the low and high words of a
+// branchSet has a branch in want. It avoids allocating, since it is called
+// for every candidate document.
+func inBranches(low uint64, high []uint64, want branchSet) bool {
+	if len(want) == 0 {
+		return false
+	}
+	if low&want[0] != 0 {
+		return true
+	}
+	return branchSet(high).intersects(want[1:])
+}
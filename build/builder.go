@@ -38,6 +38,7 @@ import (
 	"time"
 
 	"github.com/bmatcuk/doublestar"
+	"github.com/go-enry/go-enry/v2"
 	"github.com/grafana/regexp"
 	"github.com/rs/xid"
 	"gopkg.in/natefinch/lumberjack.v2"
@@ -99,6 +100,49 @@ type Options struct {
 	// https://github.com/bmatcuk/doublestar/tree/v1#patterns.
 	LargeFiles []string
 
+	// Transforms is a list of rules of the form "PATTERN=TRANSFORMER". Files
+	// whose path matches the glob PATTERN are rewritten by the named
+	// transformer before they are indexed, see RegisterTransformer. The
+	// rules are applied in order.
+	Transforms []string
+
+	// ExcludeFiles is a slice of glob patterns, with the same syntax as
+	// LargeFiles, of file paths which should not be indexed at all.
+	ExcludeFiles []string
+
+	// IncludeFiles is a slice of glob patterns, with the same syntax as
+	// LargeFiles. If it is not empty, only file paths matching one of the
+	// patterns are indexed. ExcludeFiles takes precedence.
+	IncludeFiles []string
+
+	// LanguageOverrides is a list of rules of the form "PATTERN=LANGUAGE".
+	// Files whose path matches the glob PATTERN get LANGUAGE instead of the
+	// detected language. The last matching rule wins.
+	LanguageOverrides []string
+
+	// SecretRules is the path to a JSON file with rules to detect secrets,
+	// eg. API keys, in documents. Documents with a secret are either skipped
+	// or indexed with the secret redacted. Findings are recorded in the
+	// shard, see zoekt.ReadSecretFindings.
+	SecretRules string
+
+	// IndexCommits is the number of recent commits of each branch which
+	// gitindex indexes as commit documents, see zoekt.Document.Commit. Zero
+	// disables commit indexing.
+	IndexCommits int
+
+	// IndexAuthors records the author of the last commit touching each file,
+	// see zoekt.Document.Author. It is only used by gitindex, which follows
+	// each file through merges like git log -- path, so a merge is only the
+	// author of files it changed itself, eg. by resolving a conflict. Only
+	// the last 10000 commits of each branch are searched; older files have
+	// no author.
+	IndexAuthors bool
+
+	// DryRun reports the decision for each file as a DryRunEntry in JSON
+	// lines on stdout instead of writing shards.
+	DryRun bool
+
 	// IsDelta is true if this run contains only the changed documents since the
 	// last run.
 	IsDelta bool
@@ -117,16 +161,26 @@ type Options struct {
 	// in the older shards for this repository.
 	changedOrRemovedFiles []string
 
+	// LanguageMap maps a language to the parser used for its symbols. Parsers
+	// which are implemented in-process, like ctags.GoCTags, work without a
+	// ctags binary.
 	LanguageMap ctags.LanguageMap
 }
 
 // HashOptions contains only the options in Options that upon modification leads to IndexState of IndexStateMismatch during the next index building.
 type HashOptions struct {
-	sizeMax          int
-	disableCTags     bool
-	ctagsPath        string
-	cTagsMustSucceed bool
-	largeFiles       []string
+	sizeMax           int
+	disableCTags      bool
+	ctagsPath         string
+	cTagsMustSucceed  bool
+	largeFiles        []string
+	transforms        []string
+	excludeFiles      []string
+	includeFiles      []string
+	languageOverrides []string
+	secretRules       string
+	indexCommits      int
+	indexAuthors      bool
 
 	// documentRankVersion is an experimental field which will change when the
 	// DocumentRanksPath content changes. If empty we ignore it.
@@ -140,6 +194,13 @@ func (o *Options) HashOptions() HashOptions {
 		ctagsPath:           o.CTagsPath,
 		cTagsMustSucceed:    o.CTagsMustSucceed,
 		largeFiles:          o.LargeFiles,
+		transforms:          o.Transforms,
+		excludeFiles:        o.ExcludeFiles,
+		includeFiles:        o.IncludeFiles,
+		languageOverrides:   o.LanguageOverrides,
+		secretRules:         o.SecretRules,
+		indexCommits:        o.IndexCommits,
+		indexAuthors:        o.IndexAuthors,
 		documentRankVersion: o.DocumentRanksVersion,
 	}
 }
@@ -154,6 +215,45 @@ func (o *Options) GetHash() string {
 	hasher.Write([]byte(fmt.Sprintf("%q", h.largeFiles)))
 	hasher.Write([]byte(fmt.Sprintf("%t", h.disableCTags)))
 
+	if len(h.transforms) > 0 {
+		hasher.Write([]byte{0})
+		hasher.Write([]byte(fmt.Sprintf("transforms %q", h.transforms)))
+	}
+
+	if len(h.excludeFiles) > 0 {
+		hasher.Write([]byte{0})
+		hasher.Write([]byte(fmt.Sprintf("exclude %q", h.excludeFiles)))
+	}
+
+	if len(h.includeFiles) > 0 {
+		hasher.Write([]byte{0})
+		hasher.Write([]byte(fmt.Sprintf("include %q", h.includeFiles)))
+	}
+
+	if len(h.languageOverrides) > 0 {
+		hasher.Write([]byte{0})
+		hasher.Write([]byte(fmt.Sprintf("languages %q", h.languageOverrides)))
+	}
+
+	if h.secretRules != "" {
+		hasher.Write([]byte{0})
+		io.WriteString(hasher, h.secretRules)
+		// Reindex when the rules change.
+		if blob, err := os.ReadFile(h.secretRules); err == nil {
+			hasher.Write(blob)
+		}
+	}
+
+	if h.indexCommits > 0 {
+		hasher.Write([]byte{0})
+		hasher.Write([]byte(fmt.Sprintf("commits %d", h.indexCommits)))
+	}
+
+	if h.indexAuthors {
+		hasher.Write([]byte{0})
+		hasher.Write([]byte("authors"))
+	}
+
 	if h.documentRankVersion != "" {
 		hasher.Write([]byte{0})
 		io.WriteString(hasher, h.documentRankVersion)
@@ -181,6 +281,35 @@ func (f largeFilesFlag) Set(value string) error {
 	return nil
 }
 
+type transformsFlag struct{ *Options }
+
+func (f transformsFlag) String() string {
+	if f.Options == nil {
+		return ""
+	}
+	return strings.Join(f.Transforms, ",")
+}
+
+func (f transformsFlag) Set(value string) error {
+	f.Transforms = append(f.Transforms, value)
+	return nil
+}
+
+// stringsFlag is a flag which can be set more than once.
+type stringsFlag struct{ values *[]string }
+
+func (f stringsFlag) String() string {
+	if f.values == nil {
+		return ""
+	}
+	return strings.Join(*f.values, ",")
+}
+
+func (f stringsFlag) Set(value string) error {
+	*f.values = append(*f.values, value)
+	return nil
+}
+
 // Flags adds flags for build options to fs. It is the "inverse" of Args.
 func (o *Options) Flags(fs *flag.FlagSet) {
 	x := *o
@@ -192,6 +321,14 @@ func (o *Options) Flags(fs *flag.FlagSet) {
 	fs.StringVar(&o.IndexDir, "index", x.IndexDir, "directory for search indices")
 	fs.BoolVar(&o.CTagsMustSucceed, "require_ctags", x.CTagsMustSucceed, "If set, ctags calls must succeed.")
 	fs.Var(largeFilesFlag{o}, "large_file", "A glob pattern where matching files are to be index regardless of their size. You can add multiple patterns by setting this more than once.")
+	fs.Var(transformsFlag{o}, "transform", "A rule PATTERN=TRANSFORMER to rewrite files matching the glob PATTERN before indexing, eg. '**/*.ipynb=notebook'. Available transformers: "+strings.Join(Transformers(), ", ")+". You can add multiple rules by setting this more than once.")
+	fs.Var(stringsFlag{&o.ExcludeFiles}, "exclude_file", "A glob pattern where matching files are not indexed. You can add multiple patterns by setting this more than once.")
+	fs.Var(stringsFlag{&o.IncludeFiles}, "include_file", "A glob pattern of files to index. If set, files matching no include pattern are not indexed. You can add multiple patterns by setting this more than once.")
+	fs.Var(stringsFlag{&o.LanguageOverrides}, "language_override", "A rule PATTERN=LANGUAGE to set the language of files matching the glob PATTERN, eg. '**/*.h=C++'. You can add multiple rules by setting this more than once.")
+	fs.StringVar(&o.SecretRules, "secret_rules", x.SecretRules, "JSON file with rules to detect secrets. Documents with a secret are skipped or redacted.")
+	fs.IntVar(&o.IndexCommits, "index_commits", x.IndexCommits, "If set, index the last N commits of each branch, which are found with type:commit queries. Only used for git repositories.")
+	fs.BoolVar(&o.IndexAuthors, "index_authors", x.IndexAuthors, "If set, record the last author of each file, which is matched by author: queries. Only used for git repositories.")
+	fs.BoolVar(&o.DryRun, "dry_run", x.DryRun, "If set, report for each file whether and why it is indexed as JSON lines on stdout instead of writing shards.")
 	fs.StringVar(&o.MemProfile, "memprofile", "", "write memory profile(s) to `file.shardnum`. Note: sets parallelism to 1.")
 
 	// Sourcegraph specific
@@ -230,6 +367,38 @@ func (o *Options) Args() []string {
 		args = append(args, "-large_file", a)
 	}
 
+	for _, a := range o.Transforms {
+		args = append(args, "-transform", a)
+	}
+
+	for _, a := range o.ExcludeFiles {
+		args = append(args, "-exclude_file", a)
+	}
+
+	for _, a := range o.IncludeFiles {
+		args = append(args, "-include_file", a)
+	}
+
+	for _, a := range o.LanguageOverrides {
+		args = append(args, "-language_override", a)
+	}
+
+	if o.SecretRules != "" {
+		args = append(args, "-secret_rules", o.SecretRules)
+	}
+
+	if o.IndexCommits > 0 {
+		args = append(args, "-index_commits", strconv.Itoa(o.IndexCommits))
+	}
+
+	if o.IndexAuthors {
+		args = append(args, "-index_authors")
+	}
+
+	if o.DryRun {
+		args = append(args, "-dry_run")
+	}
+
 	// Sourcegraph specific
 	if o.DisableCTags {
 		args = append(args, "-disable_ctags")
@@ -251,6 +420,15 @@ type Builder struct {
 
 	parserMap ctags.ParserMap
 
+	transforms []transformRule
+
+	languageOverrides []languageOverride
+
+	secretRules []secretRule
+
+	// secretFindings are the findings for the documents in todo.
+	secretFindings []zoekt.SecretFinding
+
 	building sync.WaitGroup
 
 	errMu      sync.Mutex
@@ -268,6 +446,9 @@ type Builder struct {
 	// a sortable 20 chars long id.
 	id string
 
+	// dryRunOut receives the report of a dry run, see Options.DryRun.
+	dryRunOut io.Writer
+
 	finishCalled bool
 }
 
@@ -299,6 +480,23 @@ func checkScipCTags() string {
 	return ""
 }
 
+// hasSymbolParsers returns true if symbols can be extracted for some
+// documents, either by a ctags binary or by an in-process parser.
+func (o *Options) hasSymbolParsers() bool {
+	if o.DisableCTags {
+		return false
+	}
+	if o.CTagsPath != "" || o.ScipCTagsPath != "" {
+		return true
+	}
+	for _, parser := range o.LanguageMap {
+		if ctags.IsInProcess(parser) {
+			return true
+		}
+	}
+	return false
+}
+
 // SetDefaults sets reasonable default options.
 func (o *Options) SetDefaults() {
 	if o.CTagsPath == "" && !o.DisableCTags {
@@ -540,6 +738,53 @@ func (o *Options) IgnoreSizeMax(name string) bool {
 	return false
 }
 
+// IsExcluded returns true if name matches a pattern in ExcludeFiles, or if
+// IncludeFiles is set and name matches none of its patterns.
+func (o *Options) IsExcluded(name string) bool {
+	if matchesAny(o.ExcludeFiles, name) {
+		return true
+	}
+	return len(o.IncludeFiles) > 0 && !matchesAny(o.IncludeFiles, name)
+}
+
+func matchesAny(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		if m, _ := doublestar.PathMatch(strings.TrimSpace(pattern), name); m {
+			return true
+		}
+	}
+	return false
+}
+
+type languageOverride struct {
+	pattern  string
+	language string
+}
+
+// parseLanguageOverrides parses rules of the form "PATTERN=LANGUAGE", see
+// Options.LanguageOverrides.
+func parseLanguageOverrides(rules []string) ([]languageOverride, error) {
+	var out []languageOverride
+	for _, rule := range rules {
+		i := strings.LastIndex(rule, "=")
+		if i < 0 {
+			return nil, fmt.Errorf("language override %q: want PATTERN=LANGUAGE", rule)
+		}
+		pattern, language := strings.TrimSpace(rule[:i]), strings.TrimSpace(rule[i+1:])
+		if _, err := doublestar.Match(pattern, pattern); err != nil {
+			return nil, fmt.Errorf("language override %q: %w", rule, err)
+		}
+		if language == "" {
+			return nil, fmt.Errorf("language override %q: empty language", rule)
+		}
+		if canonical, ok := enry.GetLanguageByAlias(language); ok {
+			language = canonical
+		}
+		out = append(out, languageOverride{pattern: pattern, language: language})
+	}
+	return out, nil
+}
+
 func checkIsNegatePattern(pattern string) (bool, string) {
 	negate := "!"
 
@@ -579,10 +824,32 @@ func NewBuilder(opts Options) (*Builder, error) {
 
 	b.parserMap = parserMap
 
-	b.shardLogger = &lumberjack.Logger{
-		Filename:   filepath.Join(opts.IndexDir, "zoekt-builder-shard-log.tsv"),
-		MaxSize:    100, // Megabyte
-		MaxBackups: 5,
+	b.transforms, err = parseTransforms(&b.opts)
+	if err != nil {
+		return nil, err
+	}
+
+	b.languageOverrides, err = parseLanguageOverrides(opts.LanguageOverrides)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.SecretRules != "" {
+		b.secretRules, err = loadSecretRules(opts.SecretRules)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	b.dryRunOut = os.Stdout
+
+	// Dry runs don't write to IndexDir, which may not exist.
+	if !opts.DryRun {
+		b.shardLogger = &lumberjack.Logger{
+			Filename:   filepath.Join(opts.IndexDir, "zoekt-builder-shard-log.tsv"),
+			MaxSize:    100, // Megabyte
+			MaxBackups: 5,
+		}
 	}
 
 	if opts.IsDelta {
@@ -617,6 +884,28 @@ func (b *Builder) Add(doc zoekt.Document) error {
 		return nil
 	}
 
+	// Commit documents are not files, so path patterns don't apply to them.
+	if doc.Commit {
+		return b.add(&doc)
+	}
+
+	if b.opts.IsExcluded(doc.Name) {
+		return b.Ignore(doc.Name, "excluded by pattern")
+	}
+
+	if len(b.transforms) == 0 {
+		return b.add(&doc)
+	}
+
+	for _, d := range transform(b.transforms, &doc) {
+		if err := b.add(d); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *Builder) add(doc *zoekt.Document) error {
 	allowLargeFile := b.opts.IgnoreSizeMax(doc.Name)
 
 	// Adjust trigramMax for allowed large files so we don't exclude them.
@@ -631,12 +920,31 @@ func (b *Builder) Add(doc zoekt.Document) error {
 		// files, the corresponding shard would be mostly empty, so
 		// insert a reason here too.
 		doc.SkipReason = fmt.Sprintf("document size %d larger than limit %d", len(doc.Content), b.opts.SizeMax)
+	} else if err := transcode(doc); err != nil {
+		doc.SkipReason = err.Error()
+	} else if len(doc.Content) > b.opts.SizeMax && !allowLargeFile {
+		// Transcoding to UTF-8 can grow the content up to 3x.
+		doc.SkipReason = fmt.Sprintf("document size %d larger than limit %d after transcoding from %s", len(doc.Content), b.opts.SizeMax, doc.Encoding)
 	} else if err := zoekt.CheckText(doc.Content, trigramMax); err != nil {
 		doc.SkipReason = err.Error()
 		doc.Language = "binary"
 	}
 
-	b.todo = append(b.todo, &doc)
+	if doc.Language != "binary" {
+		for i := len(b.languageOverrides) - 1; i >= 0; i-- {
+			if m, _ := doublestar.PathMatch(b.languageOverrides[i].pattern, doc.Name); m {
+				doc.Language = b.languageOverrides[i].language
+				break
+			}
+		}
+	}
+
+	if doc.SkipReason == "" && len(b.secretRules) > 0 {
+		findings := scanSecrets(b.secretRules, b.opts.RepositoryDescription.Name, doc)
+		b.secretFindings = append(b.secretFindings, findings...)
+	}
+
+	b.todo = append(b.todo, doc)
 
 	if doc.SkipReason == "" {
 		b.size += len(doc.Name) + len(doc.Content)
@@ -674,6 +982,10 @@ func (b *Builder) Finish() error {
 	b.flush()
 	b.building.Wait()
 
+	if b.opts.DryRun {
+		return b.buildError
+	}
+
 	if b.buildError != nil {
 		for tmp := range b.finishedShards {
 			log.Printf("Builder.Finish %s", tmp)
@@ -832,8 +1144,8 @@ func BranchNamesEqual(a, b []zoekt.RepositoryBranch) bool {
 }
 
 func (b *Builder) flush() error {
-	todo := b.todo
-	b.todo = nil
+	todo, findings := b.todo, b.secretFindings
+	b.todo, b.secretFindings = nil, nil
 	b.size = 0
 	b.errMu.Lock()
 	defer b.errMu.Unlock()
@@ -849,11 +1161,16 @@ func (b *Builder) flush() error {
 	shard := b.nextShardNum
 	b.nextShardNum++
 
+	if b.opts.DryRun {
+		b.buildError = b.reportShard(todo, shard)
+		return b.buildError
+	}
+
 	if b.opts.Parallelism > 1 && b.opts.MemProfile == "" {
 		b.building.Add(1)
 		go func() {
 			b.throttle <- 1
-			done, err := b.buildShard(todo, shard)
+			done, err := b.buildShard(todo, findings, shard)
 			<-b.throttle
 
 			b.errMu.Lock()
@@ -869,7 +1186,7 @@ func (b *Builder) flush() error {
 	} else {
 		// No goroutines when we're not parallel. This
 		// simplifies memory profiling.
-		done, err := b.buildShard(todo, shard)
+		done, err := b.buildShard(todo, findings, shard)
 		b.buildError = err
 		if err == nil {
 			b.finishedShards[done.temp] = done.final
@@ -928,6 +1245,12 @@ func IsLowPriority(file string) bool {
 	return testRe.MatchString(file) || isGenerated(file) || isVendored(file)
 }
 
+// IsLowPriorityDocument is like IsLowPriority, but respects the overrides in
+// d, see zoekt.Document.Generated. Documentation is low priority as well.
+func IsLowPriorityDocument(d *zoekt.Document) bool {
+	return testRe.MatchString(d.Name) || isGeneratedDocument(d) || isVendoredDocument(d) || isDocumentation(d)
+}
+
 var testRe = regexp.MustCompile("[Tt]est")
 
 func isGenerated(file string) bool {
@@ -938,6 +1261,26 @@ func isVendored(file string) bool {
 	return strings.Contains(file, "vendor/") || strings.Contains(file, "node_modules/")
 }
 
+func isGeneratedDocument(d *zoekt.Document) bool {
+	if d.Generated != nil {
+		return *d.Generated
+	}
+	return isGenerated(d.Name)
+}
+
+func isVendoredDocument(d *zoekt.Document) bool {
+	if d.Vendored != nil {
+		return *d.Vendored
+	}
+	return isVendored(d.Name)
+}
+
+// isDocumentation only returns true if d is marked as documentation, since
+// documentation is hard to tell apart from source by name.
+func isDocumentation(d *zoekt.Document) bool {
+	return d.Documentation != nil && *d.Documentation
+}
+
 type rankedDoc struct {
 	*zoekt.Document
 	rank []float64
@@ -949,15 +1292,20 @@ type rankedDoc struct {
 // have a higher chance of being searched before limits kick in.
 func rank(d *zoekt.Document, origIdx int) []float64 {
 	generated := 0.0
-	if isGenerated(d.Name) {
+	if isGeneratedDocument(d) {
 		generated = 1.0
 	}
 
 	vendor := 0.0
-	if isVendored(d.Name) {
+	if isVendoredDocument(d) {
 		vendor = 1.0
 	}
 
+	documentation := 0.0
+	if isDocumentation(d) {
+		documentation = 1.0
+	}
+
 	test := 0.0
 	if testRe.MatchString(d.Name) {
 		test = 1.0
@@ -971,6 +1319,9 @@ func rank(d *zoekt.Document, origIdx int) []float64 {
 		// Prefer docs that are not vendored
 		vendor,
 
+		// Prefer docs that are not documentation
+		documentation,
+
 		// Prefer docs that are not tests
 		test,
 
@@ -1016,8 +1367,8 @@ func sortDocuments(todo []*zoekt.Document) {
 	}
 }
 
-func (b *Builder) buildShard(todo []*zoekt.Document, nextShardNum int) (*finishedShard, error) {
-	if !b.opts.DisableCTags && (b.opts.CTagsPath != "" || b.opts.ScipCTagsPath != "") {
+func (b *Builder) buildShard(todo []*zoekt.Document, findings []zoekt.SecretFinding, nextShardNum int) (*finishedShard, error) {
+	if b.opts.hasSymbolParsers() {
 		err := ctagsAddSymbolsParserMap(todo, b.opts.LanguageMap, b.parserMap)
 		if b.opts.CTagsMustSucceed && err != nil {
 			return nil, err
@@ -1041,13 +1392,14 @@ func (b *Builder) buildShard(todo []*zoekt.Document, nextShardNum int) (*finishe
 			return nil, err
 		}
 	}
+	shardBuilder.AddSecretFindings(findings...)
 
 	return b.writeShard(name, shardBuilder)
 }
 
 func (b *Builder) newShardBuilder() (*zoekt.IndexBuilder, error) {
 	desc := b.opts.RepositoryDescription
-	desc.HasSymbols = !b.opts.DisableCTags && b.opts.CTagsPath != ""
+	desc.HasSymbols = b.opts.hasSymbolParsers()
 	desc.SubRepoMap = b.opts.SubRepositories
 	desc.IndexOptions = b.opts.GetHash()
 
@@ -129,6 +129,44 @@ func TestFlags(t *testing.T) {
 		want: Options{
 			LargeFiles: []string{"*.md", "\\!*.yaml"},
 		},
+	}, {
+		// multiple transform rules
+		args: []string{"-transform", "**/*.ipynb=notebook", "-transform", "*.gz=gzip"},
+		want: Options{
+			Transforms: []string{"**/*.ipynb=notebook", "*.gz=gzip"},
+		},
+	}, {
+		args: []string{"-exclude_file", "vendor/**", "-language_override", "*.h=C++", "-language_override", "*.tpl=Go"},
+		want: Options{
+			ExcludeFiles:      []string{"vendor/**"},
+			LanguageOverrides: []string{"*.h=C++", "*.tpl=Go"},
+		},
+	}, {
+		args: []string{"-secret_rules", "/etc/zoekt/secrets.json"},
+		want: Options{
+			SecretRules: "/etc/zoekt/secrets.json",
+		},
+	}, {
+		args: []string{"-include_file", "services/api/**", "-include_file", "libs/**", "-exclude_file", "libs/legacy/**"},
+		want: Options{
+			IncludeFiles: []string{"services/api/**", "libs/**"},
+			ExcludeFiles: []string{"libs/legacy/**"},
+		},
+	}, {
+		args: []string{"-index_commits", "20"},
+		want: Options{
+			IndexCommits: 20,
+		},
+	}, {
+		args: []string{"-index_authors"},
+		want: Options{
+			IndexAuthors: true,
+		},
+	}, {
+		args: []string{"-dry_run"},
+		want: Options{
+			DryRun: true,
+		},
 	}}
 
 	ignored := []cmp.Option{
@@ -469,6 +507,22 @@ func TestBuilder_DeltaShardsBuildsShouldErrorOnBranchSet(t *testing.T) {
 	}
 }
 
+func TestGetHashDistinguishesPatternOptions(t *testing.T) {
+	hashes := map[string]string{}
+	for name, o := range map[string]Options{
+		"Transforms":        {Transforms: []string{"x"}},
+		"ExcludeFiles":      {ExcludeFiles: []string{"x"}},
+		"IncludeFiles":      {IncludeFiles: []string{"x"}},
+		"LanguageOverrides": {LanguageOverrides: []string{"x"}},
+	} {
+		h := o.GetHash()
+		if other, ok := hashes[h]; ok {
+			t.Errorf("%s and %s have the same hash", name, other)
+		}
+		hashes[h] = name
+	}
+}
+
 func TestBuilder_DeltaShardsBuildsShouldErrorOnIndexOptionsMismatch(t *testing.T) {
 	repository := zoekt.Repository{
 		Name:     "repo",
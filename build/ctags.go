@@ -17,6 +17,7 @@ package build
 import (
 	"bytes"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/sourcegraph/zoekt"
@@ -59,9 +60,20 @@ func ctagsAddSymbolsParserMap(todo []*zoekt.Document, languageMap ctags.Language
 			}
 		}
 
-		es, err := parser.Parse(doc.Name, doc.Content)
-		if err != nil {
-			return err
+		var es []*ctags.Entry
+		var err error
+		if rp, ok := parser.(ctags.ReferenceParser); ok && doc.References == nil {
+			var refs []ctags.Reference
+			es, refs, err = rp.ParseAll(doc.Name, doc.Content)
+			if err != nil {
+				return err
+			}
+			doc.References = referencesToSections(doc.Content, refs)
+		} else {
+			es, err = parser.Parse(doc.Name, doc.Content)
+			if err != nil {
+				return err
+			}
 		}
 		if len(es) == 0 {
 			continue
@@ -158,6 +170,25 @@ func tagsToSections(content []byte, tags []*ctags.Entry) ([]zoekt.DocumentSectio
 	return symOffsets, symMetaData, nil
 }
 
+// referencesToSections converts references to sorted, non-overlapping byte
+// ranges. References which don't match content are dropped.
+func referencesToSections(content []byte, refs []ctags.Reference) []zoekt.DocumentSection {
+	sort.Slice(refs, func(i, j int) bool { return refs[i].Offset < refs[j].Offset })
+
+	var secs []zoekt.DocumentSection
+	for _, r := range refs {
+		start, end := r.Offset, r.Offset+uint32(len(r.Name))
+		if r.Name == "" || end > uint32(len(content)) || string(content[start:end]) != r.Name {
+			continue
+		}
+		if len(secs) > 0 && secs[len(secs)-1].End > start {
+			continue
+		}
+		secs = append(secs, zoekt.DocumentSection{Start: start, End: end})
+	}
+	return secs
+}
+
 func newLinesIndices(in []byte) []uint32 {
 	out := make([]uint32, 0, len(in)/30)
 	for i, c := range in {
@@ -0,0 +1,76 @@
+package build
+
+import (
+	"encoding/json"
+	"path/filepath"
+
+	"github.com/sourcegraph/zoekt"
+)
+
+// DryRunEntry is the line reported for each file in a dry run, see
+// Options.DryRun.
+type DryRunEntry struct {
+	Repository string `json:"repository"`
+	Name       string `json:"name"`
+
+	// Indexed is false if the file is only recorded with a SkipReason, or
+	// not added to the index at all, eg. because it matches an ignore file.
+	Indexed    bool   `json:"indexed"`
+	SkipReason string `json:"skip_reason,omitempty"`
+
+	Language string   `json:"language,omitempty"`
+	Encoding string   `json:"encoding,omitempty"`
+	Size     int      `json:"size"`
+	Branches []string `json:"branches,omitempty"`
+
+	// Shard is the name of the shard the file would be written to. It is
+	// empty for files which are not added to the index.
+	Shard string `json:"shard,omitempty"`
+}
+
+// Ignore records that a file is deliberately not added to the index, eg.
+// because it matches an ignore file. It is only reported in dry runs, so
+// that every file of the input shows up in the report.
+func (b *Builder) Ignore(name, reason string) error {
+	if !b.opts.DryRun {
+		return nil
+	}
+	return b.report(DryRunEntry{
+		Repository: b.opts.RepositoryDescription.Name,
+		Name:       name,
+		SkipReason: reason,
+	})
+}
+
+// reportShard reports the documents of shard n in a dry run.
+func (b *Builder) reportShard(todo []*zoekt.Document, n int) error {
+	shard := filepath.Base(b.opts.shardName(n))
+	for _, d := range todo {
+		size := len(d.Content)
+		if d.Content == nil {
+			size = int(d.Size)
+		}
+		e := DryRunEntry{
+			Repository: b.opts.RepositoryDescription.Name,
+			Name:       d.Name,
+			Indexed:    d.SkipReason == "",
+			SkipReason: d.SkipReason,
+			Encoding:   d.Encoding,
+			Size:       size,
+			Branches:   d.Branches,
+			Shard:      shard,
+		}
+		if e.Indexed {
+			zoekt.DetermineLanguageIfUnknown(d)
+		}
+		e.Language = d.Language
+		if err := b.report(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *Builder) report(e DryRunEntry) error {
+	return json.NewEncoder(b.dryRunOut).Encode(e)
+}
@@ -0,0 +1,77 @@
+package build
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/zoekt"
+)
+
+func TestDryRun(t *testing.T) {
+	// A dry run must not create the index directory.
+	dir := filepath.Join(t.TempDir(), "index")
+	opts := Options{
+		IndexDir:     dir,
+		SizeMax:      20,
+		ExcludeFiles: []string{"vendor/**"},
+		DryRun:       true,
+		RepositoryDescription: zoekt.Repository{
+			Name:     "repo",
+			Branches: []zoekt.RepositoryBranch{{Name: "main", Version: "v1"}},
+		},
+	}
+	b, err := NewBuilder(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var out bytes.Buffer
+	b.dryRunOut = &out
+
+	for _, doc := range []zoekt.Document{
+		{Name: "main.go", Content: []byte("package main\n"), Branches: []string{"main"}},
+		{Name: "big.txt", Content: bytes.Repeat([]byte("x"), 30), Branches: []string{"main"}},
+		{Name: "vendor/lib.go", Content: []byte("package lib\n"), Branches: []string{"main"}},
+		{Name: "huge.bin", SkipReason: "file size 1000 exceeds maximum size 20", Size: 1000, Branches: []string{"main"}},
+	} {
+		if err := b.Add(doc); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := b.Ignore("secret.txt", "ignored by .sourcegraph/ignore"); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.Finish(); err != nil {
+		t.Fatal(err)
+	}
+
+	var got []DryRunEntry
+	dec := json.NewDecoder(&out)
+	for dec.More() {
+		var e DryRunEntry
+		if err := dec.Decode(&e); err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, e)
+	}
+
+	shard := "repo_v16.00000.zoekt"
+	want := []DryRunEntry{
+		{Repository: "repo", Name: "vendor/lib.go", SkipReason: "excluded by pattern"},
+		{Repository: "repo", Name: "secret.txt", SkipReason: "ignored by .sourcegraph/ignore"},
+		{Repository: "repo", Name: "main.go", Indexed: true, Language: "Go", Size: 13, Branches: []string{"main"}, Shard: shard},
+		{Repository: "repo", Name: "big.txt", SkipReason: "document size 30 larger than limit 20", Size: 30, Branches: []string{"main"}, Shard: shard},
+		{Repository: "repo", Name: "huge.bin", SkipReason: "file size 1000 exceeds maximum size 20", Size: 1000, Branches: []string{"main"}, Shard: shard},
+	}
+	if d := cmp.Diff(want, got); d != "" {
+		t.Errorf("mismatch (-want +got):\n%s", d)
+	}
+
+	if _, err := os.Stat(dir); !os.IsNotExist(err) {
+		t.Errorf("dry run created the index directory: %v", err)
+	}
+}
@@ -35,6 +35,7 @@ import (
 	"github.com/grafana/regexp"
 
 	"github.com/sourcegraph/zoekt"
+	"github.com/sourcegraph/zoekt/ctags"
 	"github.com/sourcegraph/zoekt/query"
 	"github.com/sourcegraph/zoekt/shards"
 )
@@ -248,6 +249,83 @@ func TestLargeFileOption(t *testing.T) {
 	defer ss.Close()
 }
 
+func TestInProcessSymbols(t *testing.T) {
+	dir := t.TempDir()
+
+	opts := Options{
+		IndexDir: dir,
+		RepositoryDescription: zoekt.Repository{
+			Name: "repo",
+		},
+		// The Go parser is in-process, so this works without ctags.
+		LanguageMap: ctags.LanguageMap{"go": ctags.GoCTags},
+	}
+
+	b, err := NewBuilder(opts)
+	if err != nil {
+		t.Fatalf("NewBuilder: %v", err)
+	}
+	if err := b.AddFile("main.go", []byte("package main\n\ntype Server struct {\n\taddr string\n}\n\nfunc (s *Server) Serve() {}\n")); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.Finish(); err != nil {
+		t.Fatalf("Finish: %v", err)
+	}
+
+	ss, err := shards.NewDirectorySearcher(dir)
+	if err != nil {
+		t.Fatalf("NewDirectorySearcher(%s): %v", dir, err)
+	}
+	defer ss.Close()
+
+	q, err := query.Parse("sym:Serve")
+	if err != nil {
+		t.Fatal(err)
+	}
+	result, err := ss.Search(context.Background(), q, &zoekt.SearchOptions{})
+	if err != nil {
+		t.Fatalf("Search(%v): %v", q, err)
+	}
+
+	var got []zoekt.Symbol
+	for _, f := range result.Files {
+		for _, l := range f.LineMatches {
+			for _, frag := range l.LineFragments {
+				if frag.SymbolInfo != nil {
+					got = append(got, *frag.SymbolInfo)
+				}
+			}
+		}
+	}
+	want := []zoekt.Symbol{
+		{Sym: "Serve", Kind: "func", Parent: "Server", ParentKind: "struct"},
+		{Sym: "Server", Kind: "struct"},
+	}
+	if d := cmp.Diff(want, got, cmpopts.SortSlices(func(a, b zoekt.Symbol) bool { return a.Sym < b.Sym })); d != "" {
+		t.Errorf("symbols mismatch (-want +got):\n%s", d)
+	}
+
+	// The Go parser also records references, so ref: only matches the
+	// receiver and not the definition of Server.
+	q, err = query.Parse("ref:Server")
+	if err != nil {
+		t.Fatal(err)
+	}
+	result, err = ss.Search(context.Background(), q, &zoekt.SearchOptions{})
+	if err != nil {
+		t.Fatalf("Search(%v): %v", q, err)
+	}
+	var lines []int
+	for _, f := range result.Files {
+		for _, l := range f.LineMatches {
+			lines = append(lines, l.LineNumber)
+		}
+	}
+	if d := cmp.Diff([]int{7}, lines); d != "" {
+		t.Errorf("reference lines mismatch (-want +got):\n%s", d)
+	}
+}
+
 func TestUpdate(t *testing.T) {
 	dir := t.TempDir()
 
@@ -0,0 +1,185 @@
+package build
+
+import (
+	"bytes"
+	"fmt"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding/ianaindex"
+
+	"github.com/sourcegraph/zoekt"
+)
+
+// transcode replaces the content of doc by its UTF-8 encoding if it looks
+// like text in another encoding, and records the original encoding in
+// doc.Encoding. Content which is UTF-8 or looks binary is left alone.
+func transcode(doc *zoekt.Document) error {
+	if doc.Encoding != "" {
+		return nil
+	}
+	name, content := detectEncoding(doc.Content)
+	if name == "" {
+		return nil
+	}
+
+	enc, err := ianaindex.IANA.Encoding(name)
+	if err != nil {
+		return err
+	}
+	decoded, err := enc.NewDecoder().Bytes(content)
+	if err != nil {
+		return fmt.Errorf("transcoding from %s: %w", name, err)
+	}
+
+	doc.Content = decoded
+	doc.Encoding = name
+	return nil
+}
+
+var (
+	bomUTF16LE = []byte{0xFF, 0xFE}
+	bomUTF16BE = []byte{0xFE, 0xFF}
+)
+
+// detectEncoding returns the IANA name of the encoding of content, and
+// content without a byte order mark. It returns "" for UTF-8 and for content
+// which is probably binary.
+//
+// Without a byte order mark UTF-16 is detected by the NUL bytes of ASCII
+// characters. Content which is mostly UTF-8 with a few invalid bytes is left
+// as is. Other content is Shift_JIS if it decodes to Japanese kana,
+// otherwise it is a single-byte Western encoding.
+func detectEncoding(content []byte) (string, []byte) {
+	switch {
+	case bytes.HasPrefix(content, bomUTF16LE):
+		return "UTF-16LE", content[len(bomUTF16LE):]
+	case bytes.HasPrefix(content, bomUTF16BE):
+		return "UTF-16BE", content[len(bomUTF16BE):]
+	}
+
+	if name := detectUTF16(content); name != "" {
+		return name, content
+	}
+
+	if utf8.Valid(content) || looksBinary(content) || mostlyUTF8(content) {
+		return "", content
+	}
+
+	if isShiftJIS(content) {
+		return "Shift_JIS", content
+	}
+
+	// Bytes 0x80-0x9F are control characters in ISO-8859-1, but printable
+	// in windows-1252.
+	for _, c := range content {
+		if c >= 0x80 && c <= 0x9F {
+			return "windows-1252", content
+		}
+	}
+	return "ISO-8859-1", content
+}
+
+// sniffLen is the length of the prefix of content used to guess the
+// encoding.
+const sniffLen = 4096
+
+// detectUTF16 detects UTF-16 without a byte order mark, based on the NUL
+// bytes of ASCII characters, which are all at even or all at odd offsets.
+func detectUTF16(content []byte) string {
+	if len(content) > sniffLen {
+		content = content[:sniffLen]
+	}
+	if len(content) < 4 || len(content)%2 != 0 {
+		return ""
+	}
+
+	var even, odd int
+	for i := 0; i < len(content); i += 2 {
+		if content[i] == 0 {
+			even++
+		}
+		if content[i+1] == 0 {
+			odd++
+		}
+	}
+
+	pairs := len(content) / 2
+	switch {
+	case odd*2 > pairs && even == 0:
+		return "UTF-16LE"
+	case even*2 > pairs && odd == 0:
+		return "UTF-16BE"
+	}
+	return ""
+}
+
+// mostlyUTF8 returns true unless invalid UTF-8 clearly dominates the
+// non-ASCII content, ie. at least a fifth of its sequences are valid
+// multibyte UTF-8. Text in other encodings rarely contains valid UTF-8 by
+// accident, while UTF-8 files are sometimes damaged by a few stray bytes.
+func mostlyUTF8(content []byte) bool {
+	var valid, invalid int
+	for i := 0; i < len(content); {
+		if content[i] < utf8.RuneSelf {
+			i++
+			continue
+		}
+		r, size := utf8.DecodeRune(content[i:])
+		if r == utf8.RuneError && size == 1 {
+			invalid++
+		} else {
+			valid++
+		}
+		i += size
+	}
+	return valid*4 >= invalid
+}
+
+// looksBinary returns true if content has NUL bytes or many control
+// characters, which do not occur in text in single or double byte
+// encodings.
+func looksBinary(content []byte) bool {
+	if len(content) > sniffLen {
+		content = content[:sniffLen]
+	}
+	control := 0
+	for _, c := range content {
+		switch {
+		case c == 0:
+			return true
+		case c < 0x20 && c != '\t' && c != '\n' && c != '\r' && c != '\f' && c != '\v' && c != 0x1b:
+			control++
+		}
+	}
+	return control*20 > len(content)
+}
+
+// isShiftJIS returns true if content is valid Shift_JIS and has a double
+// byte hiragana or katakana character. Requiring kana avoids mistaking
+// Western text for kanji.
+func isShiftJIS(content []byte) bool {
+	kana := false
+	for i := 0; i < len(content); i++ {
+		c := content[i]
+		switch {
+		case c < 0x80 || (c >= 0xA1 && c <= 0xDF):
+			// ASCII or half-width katakana.
+		case (c >= 0x81 && c <= 0x9F) || (c >= 0xE0 && c <= 0xFC):
+			if i+1 >= len(content) {
+				return false
+			}
+			t := content[i+1]
+			if t < 0x40 || t == 0x7F || t > 0xFC {
+				return false
+			}
+			// Lead bytes 0x82 and 0x83 are hiragana and katakana.
+			if c == 0x82 || c == 0x83 {
+				kana = true
+			}
+			i++
+		default:
+			return false
+		}
+	}
+	return kana
+}
@@ -0,0 +1,125 @@
+package build
+
+import (
+	"context"
+	"testing"
+
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/encoding/unicode"
+
+	"github.com/sourcegraph/zoekt"
+	"github.com/sourcegraph/zoekt/query"
+	"github.com/sourcegraph/zoekt/shards"
+)
+
+func mustEncode(t *testing.T, s string, enc interface{ Bytes([]byte) ([]byte, error) }) []byte {
+	t.Helper()
+	b, err := enc.Bytes([]byte(s))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func TestTranscode(t *testing.T) {
+	utf16LE := unicode.UTF16(unicode.LittleEndian, unicode.IgnoreBOM).NewEncoder()
+	utf16BE := unicode.UTF16(unicode.BigEndian, unicode.IgnoreBOM).NewEncoder()
+	withBOM := unicode.UTF16(unicode.LittleEndian, unicode.UseBOM).NewEncoder()
+
+	for _, tc := range []struct {
+		name     string
+		content  []byte
+		encoding string
+		want     string
+	}{
+		{"utf-8", []byte("café\n"), "", "café\n"},
+		{"utf-16le", mustEncode(t, "hello world\n", utf16LE), "UTF-16LE", "hello world\n"},
+		{"utf-16be", mustEncode(t, "hello world\n", utf16BE), "UTF-16BE", "hello world\n"},
+		{"utf-16 bom", mustEncode(t, "héllo\n", withBOM), "UTF-16LE", "héllo\n"},
+		{"shift_jis", mustEncode(t, "// こんにちは世界\n", japanese.ShiftJIS.NewEncoder()), "Shift_JIS", "// こんにちは世界\n"},
+		{"latin-1", []byte("caf\xe9 r\xe9sum\xe9\n"), "ISO-8859-1", "café résumé\n"},
+		{"windows-1252", []byte("\x93quoted\x94\n"), "windows-1252", "“quoted”\n"},
+		{"mostly utf-8", []byte("café résumé na\xefve\n"), "", "café résumé na\xefve\n"},
+		{"binary", []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\xff"), "", "\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\xff"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			doc := zoekt.Document{Name: "f", Content: tc.content}
+			if err := transcode(&doc); err != nil {
+				t.Fatal(err)
+			}
+			if doc.Encoding != tc.encoding {
+				t.Errorf("got encoding %q, want %q", doc.Encoding, tc.encoding)
+			}
+			if string(doc.Content) != tc.want {
+				t.Errorf("got content %q, want %q", doc.Content, tc.want)
+			}
+		})
+	}
+}
+
+func TestBuilderTranscodes(t *testing.T) {
+	dir := t.TempDir()
+	opts := Options{
+		IndexDir: dir,
+		RepositoryDescription: zoekt.Repository{
+			Name: "repo",
+		},
+	}
+	b, err := NewBuilder(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	content := mustEncode(t, "Public Sub Main()\r\n  ' こんにちは\r\n", unicode.UTF16(unicode.LittleEndian, unicode.UseBOM).NewEncoder())
+	if err := b.AddFile("main.vb", content); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.Finish(); err != nil {
+		t.Fatal(err)
+	}
+
+	ss, err := shards.NewDirectorySearcher(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ss.Close()
+
+	res, err := ss.Search(context.Background(), &query.Substring{Pattern: "こんにちは", Content: true}, &zoekt.SearchOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Files) != 1 {
+		t.Fatalf("got %d files, want 1", len(res.Files))
+	}
+	f := res.Files[0]
+	if f.Encoding != "UTF-16LE" {
+		t.Errorf("got encoding %q, want UTF-16LE", f.Encoding)
+	}
+	if len(f.LineMatches) != 1 || string(f.LineMatches[0].Line) != "  ' こんにちは\r" {
+		t.Errorf("got line matches %+v", f.LineMatches)
+	}
+}
+
+func TestBuilderTranscodeSizeMax(t *testing.T) {
+	dir := t.TempDir()
+	opts := Options{
+		IndexDir: dir,
+		SizeMax:  10,
+		RepositoryDescription: zoekt.Repository{
+			Name: "repo",
+		},
+	}
+	b, err := NewBuilder(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 8 bytes of windows-1252 which are 16 bytes of UTF-8.
+	if err := b.AddFile("quotes.txt", []byte("\x93\x94\x93\x94\x93\x94\x93\x94")); err != nil {
+		t.Fatal(err)
+	}
+	if len(b.todo) != 1 || b.todo[0].SkipReason == "" {
+		t.Fatalf("got %+v, want a skipped document", b.todo)
+	}
+	if err := b.Finish(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,182 @@
+package build
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+
+	"github.com/grafana/regexp"
+
+	"github.com/sourcegraph/zoekt"
+)
+
+// secretRulesFile is the format of the file in Options.SecretRules, eg.
+//
+//	{
+//	  "action": "skip",
+//	  "rules": [
+//	    {"id": "aws-access-key", "regex": "AKIA[0-9A-Z]{16}"},
+//	    {"id": "api-key", "regex": "api_key = \"([^\"]+)\"", "group": 1, "min_entropy": 3.5, "action": "redact"}
+//	  ]
+//	}
+type secretRulesFile struct {
+	// Action is the default action of the rules, "skip" or "redact". It
+	// defaults to "skip".
+	Action string `json:"action"`
+
+	Rules []struct {
+		ID    string `json:"id"`
+		Regex string `json:"regex"`
+
+		// Group is the submatch of Regex which is the secret. It defaults to
+		// the whole match.
+		Group int `json:"group"`
+
+		// MinEntropy is the minimum Shannon entropy in bits per byte of the
+		// secret. It is used to ignore placeholders like "changeme".
+		MinEntropy float64 `json:"min_entropy"`
+
+		Action string `json:"action"`
+	} `json:"rules"`
+}
+
+type secretRule struct {
+	id         string
+	re         *regexp.Regexp
+	group      int
+	minEntropy float64
+	action     zoekt.SecretAction
+}
+
+// loadSecretRules reads the rules in path, see secretRulesFile.
+func loadSecretRules(path string) ([]secretRule, error) {
+	blob, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var f secretRulesFile
+	if err := json.Unmarshal(blob, &f); err != nil {
+		return nil, fmt.Errorf("secret rules %s: %w", path, err)
+	}
+
+	defaultAction, err := parseSecretAction(f.Action, zoekt.SecretSkipped)
+	if err != nil {
+		return nil, fmt.Errorf("secret rules %s: %w", path, err)
+	}
+
+	var rules []secretRule
+	for _, r := range f.Rules {
+		if r.ID == "" {
+			return nil, fmt.Errorf("secret rules %s: rule %q has no id", path, r.Regex)
+		}
+		re, err := regexp.Compile(r.Regex)
+		if err != nil {
+			return nil, fmt.Errorf("secret rule %s: %w", r.ID, err)
+		}
+		if r.Group < 0 || r.Group > re.NumSubexp() {
+			return nil, fmt.Errorf("secret rule %s: regex has no group %d", r.ID, r.Group)
+		}
+		action, err := parseSecretAction(r.Action, defaultAction)
+		if err != nil {
+			return nil, fmt.Errorf("secret rule %s: %w", r.ID, err)
+		}
+		rules = append(rules, secretRule{
+			id:         r.ID,
+			re:         re,
+			group:      r.Group,
+			minEntropy: r.MinEntropy,
+			action:     action,
+		})
+	}
+	return rules, nil
+}
+
+func parseSecretAction(s string, def zoekt.SecretAction) (zoekt.SecretAction, error) {
+	switch s {
+	case "":
+		return def, nil
+	case "skip":
+		return zoekt.SecretSkipped, nil
+	case "redact":
+		return zoekt.SecretRedacted, nil
+	}
+	return "", fmt.Errorf("unknown action %q, want skip or redact", s)
+}
+
+// scanSecrets applies rules to doc. If a rule with the skip action matches,
+// doc gets a SkipReason. Otherwise all secrets are replaced by '*' of the
+// same length, so offsets into the content stay valid. It returns a finding
+// for each secret.
+func scanSecrets(rules []secretRule, repo string, doc *zoekt.Document) []zoekt.SecretFinding {
+	type span struct{ start, end int }
+
+	var (
+		findings []zoekt.SecretFinding
+		redact   []span
+		skipRule string
+	)
+	for _, r := range rules {
+		for _, m := range r.re.FindAllSubmatchIndex(doc.Content, -1) {
+			start, end := m[2*r.group], m[2*r.group+1]
+			if start < 0 || start == end {
+				continue
+			}
+			if shannonEntropy(doc.Content[start:end]) < r.minEntropy {
+				continue
+			}
+
+			findings = append(findings, zoekt.SecretFinding{
+				Repository: repo,
+				FileName:   doc.Name,
+				Rule:       r.id,
+				Line:       bytes.Count(doc.Content[:start], []byte{'\n'}) + 1,
+				Action:     r.action,
+			})
+			if r.action == zoekt.SecretSkipped && skipRule == "" {
+				skipRule = r.id
+			}
+			redact = append(redact, span{start, end})
+		}
+	}
+
+	if len(findings) == 0 {
+		return nil
+	}
+
+	if skipRule != "" {
+		doc.SkipReason = fmt.Sprintf("secret detected by rule %s", skipRule)
+		for i := range findings {
+			findings[i].Action = zoekt.SecretSkipped
+		}
+		return findings
+	}
+
+	// Copy the content since it may be shared with the caller.
+	content := append([]byte(nil), doc.Content...)
+	for _, s := range redact {
+		for i := s.start; i < s.end; i++ {
+			content[i] = '*'
+		}
+	}
+	doc.Content = content
+	return findings
+}
+
+// shannonEntropy returns the entropy of b in bits per byte.
+func shannonEntropy(b []byte) float64 {
+	var counts [256]int
+	for _, c := range b {
+		counts[c]++
+	}
+	var h float64
+	for _, n := range counts {
+		if n == 0 {
+			continue
+		}
+		p := float64(n) / float64(len(b))
+		h -= p * math.Log2(p)
+	}
+	return h
+}
@@ -0,0 +1,212 @@
+package build
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/bmatcuk/doublestar"
+	"github.com/go-enry/go-enry/v2"
+
+	"github.com/sourcegraph/zoekt"
+)
+
+// TransformFunc rewrites a document before it is indexed. It returns the
+// documents to index in place of doc: usually a single modified document,
+// but it may split doc into several virtual documents, or return none to
+// drop it. A transformer can also set Language or SkipReason.
+type TransformFunc func(doc *zoekt.Document) ([]*zoekt.Document, error)
+
+var (
+	transformersMu sync.Mutex
+	// transformers maps names to constructors, which bind a transformer to
+	// the build options.
+	transformers = map[string]func(o *Options) TransformFunc{
+		"gzip":     func(o *Options) TransformFunc { return o.transformGzip },
+		"notebook": func(*Options) TransformFunc { return transformNotebook },
+	}
+)
+
+// RegisterTransformer makes a transformer available under name for use in
+// Options.Transforms. It panics if name is already registered.
+func RegisterTransformer(name string, fn TransformFunc) {
+	transformersMu.Lock()
+	defer transformersMu.Unlock()
+	if _, ok := transformers[name]; ok {
+		panic("build: RegisterTransformer called twice for " + name)
+	}
+	transformers[name] = func(*Options) TransformFunc { return fn }
+}
+
+// Transformers returns the names of the registered transformers.
+func Transformers() []string {
+	transformersMu.Lock()
+	defer transformersMu.Unlock()
+	names := make([]string, 0, len(transformers))
+	for name := range transformers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+type transformRule struct {
+	pattern string
+	name    string
+	fn      TransformFunc
+}
+
+// parseTransforms parses the rules of the form "PATTERN=TRANSFORMER" in
+// o.Transforms.
+func parseTransforms(o *Options) ([]transformRule, error) {
+	transformersMu.Lock()
+	defer transformersMu.Unlock()
+
+	var out []transformRule
+	for _, rule := range o.Transforms {
+		i := strings.LastIndex(rule, "=")
+		if i < 0 {
+			return nil, fmt.Errorf("transform %q: want PATTERN=TRANSFORMER", rule)
+		}
+		pattern, name := strings.TrimSpace(rule[:i]), strings.TrimSpace(rule[i+1:])
+		if _, err := doublestar.Match(pattern, pattern); err != nil {
+			return nil, fmt.Errorf("transform %q: %w", rule, err)
+		}
+		newFn, ok := transformers[name]
+		if !ok {
+			return nil, fmt.Errorf("transform %q: unknown transformer %q", rule, name)
+		}
+		out = append(out, transformRule{pattern: pattern, name: name, fn: newFn(o)})
+	}
+	return out, nil
+}
+
+// transform runs doc through each rule in order. A rule is applied to every
+// document produced by the previous rules whose name matches its pattern. If
+// a transformer fails, the document is kept with a SkipReason.
+func transform(rules []transformRule, doc *zoekt.Document) []*zoekt.Document {
+	docs := []*zoekt.Document{doc}
+	for _, r := range rules {
+		var next []*zoekt.Document
+		for _, d := range docs {
+			if m, _ := doublestar.PathMatch(r.pattern, d.Name); !m || d.SkipReason != "" {
+				next = append(next, d)
+				continue
+			}
+
+			out, err := r.fn(d)
+			if err != nil {
+				d.SkipReason = fmt.Sprintf("transformer %s failed: %v", r.name, err)
+				next = append(next, d)
+				continue
+			}
+			next = append(next, out...)
+		}
+		docs = next
+	}
+	return docs
+}
+
+// transformGzip replaces gzip compressed content by its uncompressed
+// content. The name is kept so that links to the file still work, but the
+// language is detected based on the name without the ".gz" suffix. At most
+// SizeMax bytes are decompressed, unless the file is exempt from the limit.
+func (o *Options) transformGzip(doc *zoekt.Document) ([]*zoekt.Document, error) {
+	r, err := gzip.NewReader(bytes.NewReader(doc.Content))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	limited := !o.IgnoreSizeMax(doc.Name)
+	var src io.Reader = r
+	if limited {
+		src = io.LimitReader(r, int64(o.SizeMax)+1)
+	}
+	content, err := io.ReadAll(src)
+	if err != nil {
+		return nil, err
+	}
+	if limited && len(content) > o.SizeMax {
+		doc.Content = nil
+		doc.SkipReason = fmt.Sprintf("uncompressed size exceeds maximum size %d", o.SizeMax)
+		return []*zoekt.Document{doc}, nil
+	}
+
+	doc.Content = content
+	doc.Symbols = nil
+	doc.SymbolsMetaData = nil
+	if doc.Language == "" {
+		name := strings.TrimSuffix(doc.Name, ".gz")
+		doc.Language = enry.GetLanguage(name, content)
+	}
+	return []*zoekt.Document{doc}, nil
+}
+
+// notebook is the subset of the Jupyter notebook format we need.
+type notebook struct {
+	Cells []struct {
+		CellType string          `json:"cell_type"`
+		Source   json.RawMessage `json:"source"`
+	} `json:"cells"`
+	Metadata struct {
+		LanguageInfo struct {
+			Name string `json:"name"`
+		} `json:"language_info"`
+		KernelSpec struct {
+			Language string `json:"language"`
+		} `json:"kernelspec"`
+	} `json:"metadata"`
+}
+
+// transformNotebook replaces a Jupyter notebook by the source of its code
+// cells, separated by empty lines. The language is taken from the notebook
+// metadata.
+func transformNotebook(doc *zoekt.Document) ([]*zoekt.Document, error) {
+	var nb notebook
+	if err := json.Unmarshal(doc.Content, &nb); err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	for _, c := range nb.Cells {
+		if c.CellType != "code" {
+			continue
+		}
+
+		// source is either a string or a list of lines.
+		var src string
+		var lines []string
+		if err := json.Unmarshal(c.Source, &lines); err == nil {
+			src = strings.Join(lines, "")
+		} else if err := json.Unmarshal(c.Source, &src); err != nil {
+			return nil, fmt.Errorf("cell source: %w", err)
+		}
+
+		if buf.Len() > 0 {
+			buf.WriteString("\n")
+		}
+		buf.WriteString(src)
+		if !strings.HasSuffix(src, "\n") {
+			buf.WriteString("\n")
+		}
+	}
+
+	doc.Content = buf.Bytes()
+	doc.Symbols = nil
+	doc.SymbolsMetaData = nil
+
+	lang := nb.Metadata.LanguageInfo.Name
+	if lang == "" {
+		lang = nb.Metadata.KernelSpec.Language
+	}
+	if canonical, ok := enry.GetLanguageByAlias(lang); ok {
+		doc.Language = canonical
+	}
+	return []*zoekt.Document{doc}, nil
+}
@@ -0,0 +1,135 @@
+package build
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/zoekt"
+)
+
+func TestTransformNotebook(t *testing.T) {
+	nb := `{
+ "cells": [
+  {"cell_type": "markdown", "source": ["# Title\n"]},
+  {"cell_type": "code", "source": ["import os\n", "print(os.getcwd())"]},
+  {"cell_type": "code", "source": "x = 1\n"}
+ ],
+ "metadata": {"language_info": {"name": "python"}}
+}`
+	docs, err := transformNotebook(&zoekt.Document{Name: "a.ipynb", Content: []byte(nb)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(docs) != 1 {
+		t.Fatalf("got %d docs, want 1", len(docs))
+	}
+
+	want := "import os\nprint(os.getcwd())\n\nx = 1\n"
+	if got := string(docs[0].Content); got != want {
+		t.Errorf("got content %q, want %q", got, want)
+	}
+	if docs[0].Language != "Python" {
+		t.Errorf("got language %q, want Python", docs[0].Language)
+	}
+}
+
+func TestTransformGzip(t *testing.T) {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write([]byte("package main\n")); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	opts := Options{SizeMax: 13}
+	docs, err := opts.transformGzip(&zoekt.Document{Name: "main.go.gz", Content: buf.Bytes()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := string(docs[0].Content); got != "package main\n" {
+		t.Errorf("got content %q", got)
+	}
+	if docs[0].Name != "main.go.gz" || docs[0].Language != "Go" {
+		t.Errorf("got name %q language %q, want main.go.gz and Go", docs[0].Name, docs[0].Language)
+	}
+
+	// The content is not decompressed beyond SizeMax, unless the file is a
+	// large file.
+	opts.SizeMax = 12
+	docs, err = opts.transformGzip(&zoekt.Document{Name: "main.go.gz", Content: buf.Bytes()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if docs[0].SkipReason == "" || docs[0].Content != nil {
+		t.Errorf("got content %q skip reason %q, want the file skipped", docs[0].Content, docs[0].SkipReason)
+	}
+
+	opts.LargeFiles = []string{"*.gz"}
+	docs, err = opts.transformGzip(&zoekt.Document{Name: "main.go.gz", Content: buf.Bytes()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := string(docs[0].Content); got != "package main\n" || docs[0].SkipReason != "" {
+		t.Errorf("got content %q skip reason %q for a large file", got, docs[0].SkipReason)
+	}
+}
+
+func TestTransform(t *testing.T) {
+	split := func(doc *zoekt.Document) ([]*zoekt.Document, error) {
+		var out []*zoekt.Document
+		for i, part := range bytes.Split(doc.Content, []byte("---\n")) {
+			out = append(out, &zoekt.Document{
+				Name:    fmt.Sprintf("%s#%d", doc.Name, i),
+				Content: part,
+			})
+		}
+		return out, nil
+	}
+	fail := func(doc *zoekt.Document) ([]*zoekt.Document, error) {
+		return nil, errors.New("boom")
+	}
+
+	rules := []transformRule{
+		{pattern: "**/*.multi", name: "split", fn: split},
+		{pattern: "**/*.bad", name: "fail", fn: fail},
+	}
+
+	var got []string
+	for _, doc := range []*zoekt.Document{
+		{Name: "a/b.multi", Content: []byte("one\n---\ntwo\n")},
+		{Name: "c.bad", Content: []byte("x")},
+		{Name: "d.txt", Content: []byte("y")},
+	} {
+		for _, d := range transform(rules, doc) {
+			got = append(got, fmt.Sprintf("%s %q %q", d.Name, d.Content, d.SkipReason))
+		}
+	}
+
+	want := []string{
+		`a/b.multi#0 "one\n" ""`,
+		`a/b.multi#1 "two\n" ""`,
+		`c.bad "x" "transformer fail failed: boom"`,
+		`d.txt "y" ""`,
+	}
+	if d := cmp.Diff(want, got); d != "" {
+		t.Errorf("mismatch (-want +got):\n%s", d)
+	}
+}
+
+func TestParseTransforms(t *testing.T) {
+	if _, err := parseTransforms(&Options{Transforms: []string{"**/*.ipynb=notebook", "*.gz = gzip"}}); err != nil {
+		t.Fatal(err)
+	}
+	for _, bad := range []string{"*.ipynb", "*.x=unknown", "[=gzip"} {
+		if _, err := parseTransforms(&Options{Transforms: []string{bad}}); err == nil {
+			t.Errorf("expected error for %q", bad)
+		}
+	}
+}
@@ -36,6 +36,9 @@ func run() int {
 	submodules := flag.Bool("submodules", true, "if set to false, do not recurse into submodules")
 	branchesStr := flag.String("branches", "HEAD", "git branches to index.")
 	branchPrefix := flag.String("prefix", "refs/heads/", "prefix for branch names")
+	tagsStr := flag.String("tags", "", "comma separated glob patterns of tags to index, e.g. 'v*'.")
+	objectBackend := flag.String("object_backend", string(gitindex.GoGitBackend), "how to read git objects: 'go-git', or 'cat-file' to stream them through git cat-file, which is faster for large repositories.")
+	maxTags := flag.Int("max_tags", 0, "index at most this many tags matching -tags, highest versions first (0 for no limit).")
 
 	incremental := flag.Bool("incremental", true, "only index changed repositories")
 	repoCacheDir := flag.String("repo_cache", "", "directory holding bare git repos, named by URL. "+
@@ -80,6 +83,16 @@ func run() int {
 		branches = strings.Split(*branchesStr, ",")
 	}
 
+	backend, err := gitindex.ParseObjectBackend(*objectBackend)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var tags []string
+	if *tagsStr != "" {
+		tags = strings.Split(*tagsStr, ",")
+	}
+
 	gitRepos := map[string]string{}
 	for _, repoDir := range flag.Args() {
 		repoDir, err := filepath.Abs(repoDir)
@@ -118,6 +131,9 @@ func run() int {
 			AllowMissingBranch:                *allowMissing,
 			BuildOptions:                      *opts,
 			Branches:                          branches,
+			Tags:                              tags,
+			MaxTags:                           *maxTags,
+			ObjectBackend:                     backend,
 			RepoDir:                           dir,
 			DeltaShardNumberFallbackThreshold: *deltaShardNumberFallbackThreshold,
 		}
@@ -0,0 +1,160 @@
+// Command zoekt-shard-stats reports what takes up space in index shards.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/dustin/go-humanize"
+	"github.com/sourcegraph/zoekt"
+)
+
+func readStats(fn string, opts zoekt.ShardStatsOptions) (*zoekt.ShardStats, error) {
+	f, err := os.Open(fn)
+	if err != nil {
+		return nil, err
+	}
+
+	iFile, err := zoekt.NewIndexFile(f)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	defer iFile.Close()
+
+	return zoekt.ReadShardStats(iFile, opts)
+}
+
+func printStats(out io.Writer, s *zoekt.ShardStats) {
+	w := tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+	defer w.Flush()
+
+	fmt.Fprintf(w, "# %s\n", s.Name)
+	fmt.Fprintf(w, "format version %d, feature version %d, id %s\n\n",
+		s.IndexMetadata.IndexFormatVersion, s.IndexMetadata.IndexFeatureVersion, s.IndexMetadata.ID)
+
+	fmt.Fprintf(w, "## sections\n")
+	for _, sec := range s.Sections {
+		fmt.Fprintf(w, "%s\t%s\t\n", sec.Name, humanize.IBytes(uint64(sec.Size)))
+	}
+
+	fmt.Fprintf(w, "\n## repositories\n")
+	for _, r := range s.Repos {
+		tombstone := ""
+		if r.Tombstone {
+			tombstone = "tombstone"
+		}
+		fileTombstones := ""
+		if r.FileTombstones > 0 {
+			fileTombstones = fmt.Sprintf("%d file tombstones", r.FileTombstones)
+		}
+		fmt.Fprintf(w, "%s\t%d docs\t%s\t%s\t%s\n", r.Name, r.Documents, humanize.IBytes(uint64(r.ContentBytes)), tombstone, fileTombstones)
+	}
+
+	fmt.Fprintf(w, "\n## languages\n")
+	printCounts(w, s.Languages)
+
+	fmt.Fprintf(w, "\n## largest documents\n")
+	for _, d := range s.LargestDocuments {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", d.Repository, d.Name, humanize.IBytes(uint64(d.Size)))
+	}
+
+	fmt.Fprintf(w, "\n## top content ngrams\n")
+	for _, ng := range s.TopContentNgrams {
+		fmt.Fprintf(w, "%q\t%d postings\t%s\n", ng.Ngram, ng.Postings, humanize.IBytes(uint64(ng.PostingBytes)))
+	}
+
+	fmt.Fprintf(w, "\n## top filename ngrams\n")
+	for _, ng := range s.TopNameNgrams {
+		fmt.Fprintf(w, "%q\t%d postings\t%s\n", ng.Ngram, ng.Postings, humanize.IBytes(uint64(ng.PostingBytes)))
+	}
+
+	fmt.Fprintf(w, "\n## posting list sizes\n")
+	for _, b := range s.PostingSizes {
+		if b.Count == 0 {
+			continue
+		}
+		fmt.Fprintf(w, ">= %s\t%d lists\t%s\n", humanize.IBytes(uint64(b.Min)), b.Count, humanize.IBytes(b.Bytes))
+	}
+
+	if len(s.SkipReasons) > 0 {
+		fmt.Fprintf(w, "\n## skip reasons\n")
+		printCounts(w, s.SkipReasons)
+	}
+
+	if len(s.SecretFindings) > 0 {
+		fmt.Fprintf(w, "\n## secret findings\n")
+		for _, f := range s.SecretFindings {
+			fmt.Fprintf(w, "%s\t%s:%d\t%s\t%s\n", f.Repository, f.FileName, f.Line, f.Rule, f.Action)
+		}
+	}
+
+	if len(s.Tombstones) > 0 {
+		fmt.Fprintf(w, "\n## tombstones\n")
+		for _, name := range s.Tombstones {
+			fmt.Fprintf(w, "%s\n", name)
+		}
+	}
+	fmt.Fprintf(w, "\n")
+}
+
+// printCounts prints counts in descending order.
+func printCounts(w io.Writer, counts map[string]int) {
+	keys := make([]string, 0, len(counts))
+	for k := range counts {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if counts[keys[i]] != counts[keys[j]] {
+			return counts[keys[i]] > counts[keys[j]]
+		}
+		return keys[i] < keys[j]
+	})
+	for _, k := range keys {
+		name := k
+		if name == "" {
+			name = "(unknown)"
+		}
+		fmt.Fprintf(w, "%s\t%d\n", name, counts[k])
+	}
+}
+
+func main() {
+	jsonOut := flag.Bool("json", false, "print stats as JSON, one object per shard")
+	topN := flag.Int("top", 10, "number of ngrams and documents to report")
+
+	flag.Usage = func() {
+		name := os.Args[0]
+		fmt.Fprintf(os.Stderr, "Usage:\n\n  %s [option] SHARD...\n\n", name)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	for _, fn := range flag.Args() {
+		s, err := readStats(fn, zoekt.ShardStatsOptions{TopN: *topN})
+		if err != nil {
+			log.Fatalf("%s: %v", fn, err)
+		}
+
+		if *jsonOut {
+			if err := enc.Encode(s); err != nil {
+				log.Fatal(err)
+			}
+		} else {
+			printStats(os.Stdout, s)
+		}
+	}
+}
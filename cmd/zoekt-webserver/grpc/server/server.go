@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"errors"
 	"math"
 
 	"github.com/sourcegraph/zoekt/grpc/chunk"
@@ -33,7 +34,7 @@ func (s *Server) Search(ctx context.Context, req *proto.SearchRequest) (*proto.S
 
 	res, err := s.streamer.Search(ctx, q, zoekt.SearchOptionsFromProto(req.GetOpts()))
 	if err != nil {
-		return nil, err
+		return nil, searchError(err)
 	}
 
 	return res.ToProto(), nil
@@ -54,6 +55,17 @@ func (s *Server) StreamSearch(req *proto.StreamSearchRequest, ss proto.Webserver
 	if err == nil {
 		sampler.Flush()
 	}
+	return searchError(err)
+}
+
+// searchError returns the gRPC status for errors of a search. Searches
+// rejected by admission control are invalid requests, so clients don't retry
+// them on another replica.
+func searchError(err error) error {
+	var costErr *zoekt.CostError
+	if errors.As(err, &costErr) {
+		return status.Error(codes.InvalidArgument, costErr.Error())
+	}
 	return err
 }
 
@@ -92,9 +104,13 @@ func gRPCChunkSender(ss proto.WebserverService_StreamSearchServer) zoekt.Sender
 			numFilesSent += len(filesChunk)
 
 			var stats *proto.Stats
+			var backendErrors []*proto.BackendError
+			var incompleteShards []*proto.IncompleteShard
 			if !statsSent { // We only send stats back on the first chunk
 				statsSent = true
 				stats = result.GetStats()
+				backendErrors = result.GetBackendErrors()
+				incompleteShards = result.GetIncompleteShards()
 			}
 
 			progress := result.GetProgress()
@@ -118,6 +134,9 @@ func gRPCChunkSender(ss proto.WebserverService_StreamSearchServer) zoekt.Sender
 
 					Stats:    stats,
 					Progress: progress,
+
+					BackendErrors:    backendErrors,
+					IncompleteShards: incompleteShards,
 				},
 			})
 		}
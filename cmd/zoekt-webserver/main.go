@@ -39,9 +39,11 @@ import (
 	"sync"
 	"time"
 
+	"github.com/dustin/go-humanize"
 	grpcprom "github.com/grpc-ecosystem/go-grpc-middleware/providers/prometheus"
 	"github.com/sourcegraph/mountinfo"
 	zoektgrpc "github.com/sourcegraph/zoekt/cmd/zoekt-webserver/grpc/server"
+	zoektgrpcclient "github.com/sourcegraph/zoekt/grpc/client"
 	"github.com/sourcegraph/zoekt/grpc/internalerrs"
 	"github.com/sourcegraph/zoekt/grpc/messagesize"
 	proto "github.com/sourcegraph/zoekt/grpc/protos/zoekt/webserver/v1"
@@ -53,6 +55,7 @@ import (
 	"github.com/sourcegraph/zoekt"
 	"github.com/sourcegraph/zoekt/build"
 	"github.com/sourcegraph/zoekt/debugserver"
+	"github.com/sourcegraph/zoekt/internal/clientid"
 	"github.com/sourcegraph/zoekt/internal/profiler"
 	"github.com/sourcegraph/zoekt/internal/tracer"
 	"github.com/sourcegraph/zoekt/query"
@@ -154,6 +157,14 @@ func main() {
 	templateDir := flag.String("template_dir", "", "set directory from which to load custom .html.tpl template files")
 	dumpTemplates := flag.Bool("dump_templates", false, "dump templates into --template_dir and exit.")
 	version := flag.Bool("version", false, "Print version number")
+	federate := flag.String("federate", "", "comma separated list of zoekt-webserver gRPC addresses. If set, run as a pure aggregator which sends queries to these backends and merges their results instead of searching -index. Replicas holding the same shards are separated by |, e.g. \"a1|a2,b1|b2\"")
+	federateTimeout := flag.Duration("federate_timeout", 0, "if set, report a backend of -federate as timed out if it takes longer than this to answer")
+	hedgePercentile := flag.Float64("federate_hedge_percentile", zoektgrpcclient.DefaultHedgeOptions.Percentile, "send a hedge request to another replica if a replica takes longer than this percentile of recent latencies")
+	hedgeMinDelay := flag.Duration("federate_hedge_min_delay", zoektgrpcclient.DefaultHedgeOptions.MinDelay, "the minimum delay before sending a hedge request to another replica")
+	resultCacheSize := flag.String("result_cache_size", "", "if set, cache search results up to this size, e.g. \"256MiB\". The cache is cleared whenever the index changes")
+	downgradeQueryCost := flag.String("downgrade_query_cost", "", "if set, run searches estimated to read more than this much index data at batch priority with smaller match limits, e.g. \"10GiB\"")
+	maxQueryCost := flag.String("max_query_cost", "", "if set, reject searches estimated to read more than this much index data, e.g. \"100GiB\"")
+	shardMemoryBudget := flag.String("shard_memory_budget", "", "if set, load shards on demand and evict the least recently used ones to keep their index memory below this size, e.g. \"8GiB\"")
 
 	flag.Parse()
 
@@ -193,25 +204,69 @@ func main() {
 	// Tune GOMAXPROCS to match Linux container CPU quota.
 	_, _ = maxprocs.Set()
 
-	if err := os.MkdirAll(*index, 0o755); err != nil {
-		log.Fatal(err)
-	}
+	// An aggregator doesn't have an index of its own.
+	if *federate == "" {
+		if err := os.MkdirAll(*index, 0o755); err != nil {
+			log.Fatal(err)
+		}
 
-	mustRegisterDiskMonitor(*index)
+		mustRegisterDiskMonitor(*index)
 
-	metricsLogger := sglog.Scoped("metricsRegistration", "")
+		metricsLogger := sglog.Scoped("metricsRegistration", "")
 
-	mustRegisterMemoryMapMetrics(metricsLogger)
+		mustRegisterMemoryMapMetrics(metricsLogger)
 
-	opts := mountinfo.CollectorOpts{Namespace: "zoekt_webserver"}
-	c := mountinfo.NewCollector(metricsLogger, opts, map[string]string{"indexDir": *index})
+		opts := mountinfo.CollectorOpts{Namespace: "zoekt_webserver"}
+		c := mountinfo.NewCollector(metricsLogger, opts, map[string]string{"indexDir": *index})
 
-	prometheus.DefaultRegisterer.MustRegister(c)
+		prometheus.DefaultRegisterer.MustRegister(c)
+	}
 
 	// Do not block on loading shards so we can become partially available
 	// sooner. Otherwise on large instances zoekt can be unavailable on the
 	// order of minutes.
-	searcher, err := shards.NewDirectorySearcherFast(*index)
+	var (
+		searcher zoekt.Streamer
+		err      error
+	)
+	if *federate != "" {
+		hedge := zoektgrpcclient.HedgeOptions{
+			Percentile: *hedgePercentile,
+			MinDelay:   *hedgeMinDelay,
+		}
+		searcher, err = newFederatedSearcher(strings.Split(*federate, ","), *federateTimeout, hedge)
+	} else {
+		var opts shards.DirectorySearcherOptions
+		if *shardMemoryBudget != "" {
+			budget, parseErr := humanize.ParseBytes(*shardMemoryBudget)
+			if parseErr != nil {
+				log.Fatalf("invalid -shard_memory_budget: %v", parseErr)
+			}
+			opts.ShardMemoryBudget = int64(budget)
+		}
+		if *resultCacheSize != "" {
+			size, parseErr := humanize.ParseBytes(*resultCacheSize)
+			if parseErr != nil {
+				log.Fatalf("invalid -result_cache_size: %v", parseErr)
+			}
+			opts.ResultCacheBytes = size
+		}
+		if *downgradeQueryCost != "" {
+			cost, parseErr := humanize.ParseBytes(*downgradeQueryCost)
+			if parseErr != nil {
+				log.Fatalf("invalid -downgrade_query_cost: %v", parseErr)
+			}
+			opts.Admission.DowngradeBytes = cost
+		}
+		if *maxQueryCost != "" {
+			cost, parseErr := humanize.ParseBytes(*maxQueryCost)
+			if parseErr != nil {
+				log.Fatalf("invalid -max_query_cost: %v", parseErr)
+			}
+			opts.Admission.RejectBytes = cost
+		}
+		searcher, err = shards.NewDirectorySearcherWithOptions(*index, opts)
+	}
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -265,7 +320,7 @@ func main() {
 		addProxyHandler(serveMux, socket)
 	}
 
-	handler := trace.Middleware(serveMux)
+	handler := trace.Middleware(clientid.Middleware(serveMux))
 
 	// Sourcegraph: We use environment variables to configure watchdog since
 	// they are more convenient than flags in containerized environments.
@@ -616,6 +671,7 @@ func (s *loggedSearcher) log(ctx context.Context, q query.Q, opts *zoekt.SearchO
 		sglog.Duration("stat.MatchTreeConstruction", st.MatchTreeConstruction),
 		sglog.Duration("stat.MatchTreeSearch", st.MatchTreeSearch),
 		sglog.Int("stat.RegexpsConsidered", st.RegexpsConsidered),
+		sglog.Int("stat.CacheHits", st.CacheHits),
 		sglog.String("stat.FlushReason", st.FlushReason.String()),
 	)
 }
@@ -650,12 +706,14 @@ func newGRPCServer(logger sglog.Logger, streamer zoekt.Streamer, additionalOpts
 			metrics.StreamServerInterceptor(),
 			messagesize.StreamServerInterceptor,
 			internalerrs.LoggingStreamServerInterceptor(logger),
+			clientid.StreamServerInterceptor,
 		),
 		grpc.ChainUnaryInterceptor(
 			otelgrpc.UnaryServerInterceptor(),
 			metrics.UnaryServerInterceptor(),
 			messagesize.UnaryServerInterceptor,
 			internalerrs.LoggingUnaryServerInterceptor(logger),
+			clientid.UnaryServerInterceptor,
 		),
 	}
 
@@ -674,6 +732,56 @@ func newGRPCServer(logger sglog.Logger, streamer zoekt.Streamer, additionalOpts
 	return s
 }
 
+// newFederatedSearcher returns a searcher which aggregates the results of the
+// zoekt-webservers listening on groups. Each group is a list of replicas
+// separated by "|".
+func newFederatedSearcher(groups []string, timeout time.Duration, hedge zoektgrpcclient.HedgeOptions) (zoekt.Streamer, error) {
+	logger := sglog.Scoped("federatedSearcher", "searches remote zoekt-webservers")
+
+	dial := func(addr string) (*zoektgrpcclient.Client, error) {
+		return zoektgrpcclient.Dial(addr,
+			grpc.WithChainStreamInterceptor(
+				otelgrpc.StreamClientInterceptor(),
+				internalerrs.LoggingStreamClientInterceptor(logger),
+				internalerrs.PrometheusStreamClientInterceptor,
+			),
+			grpc.WithChainUnaryInterceptor(
+				otelgrpc.UnaryClientInterceptor(),
+				internalerrs.LoggingUnaryClientInterceptor(logger),
+				internalerrs.PrometheusUnaryClientInterceptor,
+			),
+		)
+	}
+
+	var backends []shards.Backend
+	for _, group := range groups {
+		group = strings.TrimSpace(group)
+		if group == "" {
+			continue
+		}
+
+		var replicas []zoekt.Streamer
+		for _, addr := range strings.Split(group, "|") {
+			c, err := dial(strings.TrimSpace(addr))
+			if err != nil {
+				return nil, err
+			}
+			replicas = append(replicas, c)
+		}
+
+		backend := shards.Backend{Name: group, Streamer: replicas[0]}
+		if len(replicas) > 1 {
+			backend.Streamer = zoektgrpcclient.NewReplicaGroup(group, replicas, hedge)
+		}
+		backends = append(backends, backend)
+	}
+	if len(backends) == 0 {
+		return nil, errors.New("-federate: no backends")
+	}
+
+	return shards.NewFederatedSearcher(backends, timeout), nil
+}
+
 var (
 	metricWatchdogErrors = promauto.NewGauge(prometheus.GaugeOpts{
 		Name: "zoekt_webserver_watchdog_errors",
@@ -0,0 +1,49 @@
+package zoekt
+
+import "github.com/sourcegraph/zoekt/query"
+
+// Values of the fileKinds section.
+const (
+	docKindFile   byte = 0
+	docKindCommit byte = 1
+)
+
+// isCommit returns true if document docID is a commit document, see
+// Document.Commit.
+func (d *indexData) isCommit(docID uint32) bool {
+	return d.fileKinds != nil && d.fileKinds[docID] == docKindCommit
+}
+
+// wantsCommits returns true if q asks for commit documents, ie. it contains
+// a type:commit query.
+func wantsCommits(q query.Q) bool {
+	want := false
+	query.Map(q, func(q query.Q) query.Q {
+		if t, ok := q.(*query.Type); ok && t.Type == query.TypeCommit {
+			want = true
+		}
+		return q
+	})
+	return want
+}
+
+// commitFilter returns a matchTree which restricts mt to commit documents if
+// commits is true, and to files otherwise. It returns mt unchanged for
+// shards without commit documents.
+func (d *indexData) commitFilter(mt matchTree, commits bool) matchTree {
+	if d.fileKinds == nil {
+		return mt
+	}
+	return &andMatchTree{
+		children: []matchTree{
+			mt,
+			&docMatchTree{
+				reason:  "commit",
+				numDocs: d.numDocs(),
+				predicate: func(docID uint32) bool {
+					return d.isCommit(docID) == commits
+				},
+			},
+		},
+	}
+}
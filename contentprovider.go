@@ -39,6 +39,8 @@ type contentProvider struct {
 	_nlBuf   []uint32
 	_sects   []DocumentSection
 	_sectBuf []DocumentSection
+	_refs    []DocumentSection
+	_refBuf  []DocumentSection
 	fileSize uint32
 }
 
@@ -51,6 +53,7 @@ func (p *contentProvider) setDocument(docID uint32) {
 
 	p._nl = nil
 	p._sects = nil
+	p._refs = nil
 	p._data = nil
 }
 
@@ -64,6 +67,16 @@ func (p *contentProvider) docSections() []DocumentSection {
 	return p._sects
 }
 
+func (p *contentProvider) referenceSections() []DocumentSection {
+	if p._refs == nil {
+		var sz uint32
+		p._refs, sz, p.err = p.id.readReferences(p.idx, p._refBuf)
+		p.stats.ContentBytesLoaded += int64(sz)
+		p._refBuf = p._refs
+	}
+	return p._refs
+}
+
 func (p *contentProvider) newlines() newlines {
 	if p._nl == nil {
 		var sz uint32
@@ -461,6 +474,9 @@ const (
 	scoreSymbol           = 7000.0
 	scorePartialSymbol    = 4000.0
 	scoreKindMatch        = 100.0
+	// References rank below definitions, but above other content.
+	scoreReference        = 3000.0
+	scorePartialReference = 1500.0
 	scoreRepetitionFactor = 1.0
 	scoreFactorAtomMatch  = 400.0
 
@@ -559,6 +575,13 @@ func (p *contentProvider) chunkMatchScore(secs []DocumentSection, m *ChunkMatch,
 			if si != nil {
 				addScore(fmt.Sprintf("kind:%s:%s", language, si.Kind), scoreKind(language, si.Kind))
 			}
+		} else if refIdx, ok := findSection(p.referenceSections(), uint32(r.Start.ByteOffset), uint32(r.End.ByteOffset-r.Start.ByteOffset)); ok {
+			ref := p.referenceSections()[refIdx]
+			if ref.Start == uint32(r.Start.ByteOffset) && ref.End == uint32(r.End.ByteOffset) {
+				addScore("Reference", scoreReference)
+			} else {
+				addScore("PartialReference", scorePartialReference)
+			}
 		}
 
 		if score.score > maxScore.score {
@@ -638,6 +661,13 @@ func (p *contentProvider) matchScore(secs []DocumentSection, m *LineMatch, langu
 				// the LineFragment may not be on a symbol, then si will be nil.
 				addScore(fmt.Sprintf("kind:%s:%s", language, si.Kind), scoreKind(language, si.Kind))
 			}
+		} else if refIdx, ok := findSection(p.referenceSections(), f.Offset, uint32(f.MatchLength)); ok {
+			ref := p.referenceSections()[refIdx]
+			if ref.Start == f.Offset && ref.End == f.Offset+uint32(f.MatchLength) {
+				addScore("Reference", scoreReference)
+			} else {
+				addScore("PartialReference", scorePartialReference)
+			}
 		}
 
 		if score.score > maxScore.score {
@@ -0,0 +1,254 @@
+package zoekt
+
+import (
+	"fmt"
+	"regexp/syntax"
+	"unicode/utf8"
+
+	"github.com/sourcegraph/zoekt/query"
+)
+
+// Cost is an estimate of the work needed to evaluate a query. It is computed
+// from the index alone, without visiting any documents.
+type Cost struct {
+	// Docs is an upper bound on the number of documents the query has to
+	// consider.
+	Docs uint64
+
+	// PostingBytes is the size of the ngram posting lists which have to be
+	// read.
+	PostingBytes uint64
+
+	// BruteForceDocs is the number of documents whose content has to be
+	// scanned because parts of the query can't be answered by the ngram
+	// index alone, e.g. short literals or regular expressions.
+	BruteForceDocs uint64
+
+	// BruteForceBytes is the estimated size of the content of BruteForceDocs.
+	BruteForceBytes uint64
+}
+
+// Add adds the cost of another shard to c.
+func (c *Cost) Add(o Cost) {
+	c.Docs += o.Docs
+	c.PostingBytes += o.PostingBytes
+	c.BruteForceDocs += o.BruteForceDocs
+	c.BruteForceBytes += o.BruteForceBytes
+}
+
+// Bytes is the estimated number of bytes read to evaluate the query.
+func (c Cost) Bytes() uint64 {
+	return c.PostingBytes + c.BruteForceBytes
+}
+
+func (c Cost) String() string {
+	return fmt.Sprintf("docs=%d postings=%dB bruteforce=%d docs/%dB", c.Docs, c.PostingBytes, c.BruteForceDocs, c.BruteForceBytes)
+}
+
+// CostEstimator is implemented by Searchers which can estimate the cost of a
+// query before searching.
+type CostEstimator interface {
+	EstimateCost(q query.Q) Cost
+}
+
+// CostError is returned for searches which are rejected because their
+// estimated cost is too high.
+type CostError struct {
+	Cost Cost
+
+	// Limit is the maximum number of bytes a search may read, see
+	// Cost.Bytes.
+	Limit uint64
+}
+
+func (e *CostError) Error() string {
+	msg := fmt.Sprintf("query is too expensive: it would read about %d bytes, the limit is %d bytes", e.Cost.Bytes(), e.Limit)
+	if e.Cost.BruteForceDocs > 0 {
+		msg += fmt.Sprintf(". The content of %d documents would have to be scanned because parts of the query can't use the index (short literals or regular expressions)", e.Cost.BruteForceDocs)
+	}
+	return msg + ". Use longer literals or restrict the query with repo: or file: filters"
+}
+
+// nodeCost is the cost of a single node of a query.
+type nodeCost struct {
+	// docs is an upper bound on the number of documents matching the node.
+	docs uint64
+
+	postingBytes uint64
+
+	// verify is true if evaluating the node requires scanning the content of
+	// every document it is asked about.
+	verify bool
+}
+
+// EstimateCost implements CostEstimator.
+func (d *indexData) EstimateCost(q query.Q) Cost {
+	if len(d.fileNameIndex) == 0 {
+		return Cost{}
+	}
+
+	q = d.simplify(q)
+	if c, ok := q.(*query.Const); ok && !c.Value {
+		return Cost{}
+	}
+	q = query.Map(q, query.ExpandFileContent)
+
+	nc := d.estimate(q)
+	cost := Cost{
+		Docs:         nc.docs,
+		PostingBytes: nc.postingBytes,
+	}
+	if nc.verify && nc.docs > 0 {
+		numDocs := uint64(d.numDocs())
+		cost.BruteForceDocs = nc.docs
+		cost.BruteForceBytes = nc.docs * uint64(d.boundaries[numDocs]) / numDocs
+	}
+	return cost
+}
+
+func (d *indexData) estimate(q query.Q) nodeCost {
+	all := nodeCost{docs: uint64(d.numDocs())}
+
+	switch s := q.(type) {
+	case *query.Const:
+		if !s.Value {
+			return nodeCost{}
+		}
+		return all
+
+	case *query.Substring:
+		return d.estimateSubstring(s)
+
+	case *query.Regexp:
+		// The literals of the regexp narrow down the documents, which are
+		// then matched against the regexp.
+		nc := d.estimate(regexpToCostQuery(s.Regexp, s.FileName, s.CaseSensitive))
+		nc.verify = nc.verify || !s.FileName
+		return nc
+
+	case *query.And:
+		nc := all
+		for _, ch := range s.Children {
+			c := d.estimate(ch)
+			nc.docs = minUint64(nc.docs, c.docs)
+			nc.postingBytes += c.postingBytes
+			nc.verify = nc.verify || c.verify
+		}
+		return nc
+
+	case *query.Or:
+		var nc nodeCost
+		for _, ch := range s.Children {
+			c := d.estimate(ch)
+			nc.docs += c.docs
+			nc.postingBytes += c.postingBytes
+			nc.verify = nc.verify || c.verify
+		}
+		nc.docs = minUint64(nc.docs, all.docs)
+		return nc
+
+	case *query.Not:
+		c := d.estimate(s.Child)
+		return nodeCost{
+			docs:         all.docs,
+			postingBytes: c.postingBytes,
+			verify:       c.verify,
+		}
+
+	case *query.Symbol:
+		return d.estimate(s.Expr)
+
+	case *query.Reference:
+		return d.estimate(s.Expr)
+
+	case *query.Type:
+		return d.estimate(s.Child)
+	}
+
+	// The remaining atoms filter on metadata, which is cheap to check but
+	// doesn't narrow down the documents we visit.
+	return all
+}
+
+// estimateSubstring mirrors iterateNgrams: we read the posting lists of the
+// two least frequent ngrams, and the size of the smallest one bounds the
+// number of documents containing the substring.
+func (d *indexData) estimateSubstring(s *query.Substring) nodeCost {
+	numDocs := uint64(d.numDocs())
+	if utf8.RuneCountInString(s.Pattern) < ngramSize {
+		return nodeCost{docs: numDocs, verify: !s.FileName}
+	}
+
+	ngrams := d.ngrams(s.FileName)
+	ngramOffs := splitNGrams([]byte(s.Pattern))
+	var min0, min1 uint64
+	for i, o := range ngramOffs {
+		var freq uint64
+		if s.CaseSensitive {
+			freq = uint64(ngrams.Get(o.ngram).sz)
+		} else {
+			for _, v := range generateCaseNgrams(o.ngram) {
+				freq += uint64(ngrams.Get(v).sz)
+			}
+		}
+		if freq == 0 {
+			return nodeCost{}
+		}
+		if i == 0 {
+			min0, min1 = freq, freq
+		} else if freq < min0 {
+			min0, min1 = freq, min0
+		} else if freq < min1 {
+			min1 = freq
+		}
+	}
+
+	postingBytes := min0
+	if len(ngramOffs) > 1 {
+		postingBytes += min1
+	}
+
+	// Every document in a posting list takes at least one byte, so the size
+	// of a posting list bounds the number of documents in it.
+	return nodeCost{
+		docs:         minUint64(numDocs, min0),
+		postingBytes: postingBytes,
+	}
+}
+
+// regexpToCostQuery returns a query over the literals of r which matches a
+// superset of r. It follows regexpToMatchTreeRecursive.
+func regexpToCostQuery(r *syntax.Regexp, fileName, caseSensitive bool) query.Q {
+	switch r.Op {
+	case syntax.OpLiteral:
+		if s := string(r.Rune); len(s) >= ngramSize {
+			return &query.Substring{Pattern: s, FileName: fileName, CaseSensitive: caseSensitive}
+		}
+	case syntax.OpCapture, syntax.OpPlus:
+		return regexpToCostQuery(r.Sub[0], fileName, caseSensitive)
+	case syntax.OpRepeat:
+		if r.Min >= 1 {
+			return regexpToCostQuery(r.Sub[0], fileName, caseSensitive)
+		}
+	case syntax.OpConcat:
+		and := &query.And{}
+		for _, sr := range r.Sub {
+			and.Children = append(and.Children, regexpToCostQuery(sr, fileName, caseSensitive))
+		}
+		return and
+	case syntax.OpAlternate:
+		or := &query.Or{}
+		for _, sr := range r.Sub {
+			or.Children = append(or.Children, regexpToCostQuery(sr, fileName, caseSensitive))
+		}
+		return or
+	}
+	return &query.Const{Value: true}
+}
+
+func minUint64(a, b uint64) uint64 {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,69 @@
+package zoekt
+
+import (
+	"testing"
+
+	"github.com/sourcegraph/zoekt/query"
+)
+
+func TestEstimateCost(t *testing.T) {
+	b := testIndexBuilder(t, &Repository{Name: "repo"},
+		Document{Name: "a.go", Content: []byte("package main\nfunc needle() {}\n")},
+		Document{Name: "b.go", Content: []byte("package main\nfunc haystack() {}\n")},
+		Document{Name: "c.md", Content: []byte("# readme\n")},
+	)
+	s := searcherForTest(t, b).(CostEstimator)
+
+	mustParse := func(s string) query.Q {
+		q, err := query.Parse(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return q
+	}
+
+	cases := []struct {
+		query string
+
+		wantDocs       uint64
+		wantBruteForce bool
+	}{
+		// The ngram index narrows down the documents.
+		{query: "needle", wantDocs: 1},
+		{query: "needle or haystack", wantDocs: 2},
+		{query: "doesnotexist", wantDocs: 0},
+
+		// Short literals and regexps have to scan the content.
+		{query: "x", wantDocs: 3, wantBruteForce: true},
+		{query: "regex:.", wantDocs: 3, wantBruteForce: true},
+		{query: "regex:ne.d", wantDocs: 3, wantBruteForce: true},
+		{query: "regex:needle.*", wantDocs: 1, wantBruteForce: true},
+		{query: "-content:x", wantDocs: 3, wantBruteForce: true},
+
+		// Negating a long literal can use the index.
+		{query: "-content:needle", wantDocs: 3},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.query, func(t *testing.T) {
+			cost := s.EstimateCost(mustParse(tc.query))
+			if cost.Docs != tc.wantDocs {
+				t.Errorf("got %d docs, want %d (%s)", cost.Docs, tc.wantDocs, cost)
+			}
+			if got := cost.BruteForceDocs > 0; got != tc.wantBruteForce {
+				t.Errorf("got brute force %v, want %v (%s)", got, tc.wantBruteForce, cost)
+			}
+			if tc.wantBruteForce && cost.BruteForceBytes == 0 {
+				t.Errorf("brute force without bytes (%s)", cost)
+			}
+		})
+	}
+}
+
+func TestCostError(t *testing.T) {
+	err := &CostError{Cost: Cost{PostingBytes: 10, BruteForceDocs: 2, BruteForceBytes: 100}, Limit: 50}
+	want := "query is too expensive: it would read about 110 bytes, the limit is 50 bytes. The content of 2 documents would have to be scanned because parts of the query can't use the index (short literals or regular expressions). Use longer literals or restrict the query with repo: or file: filters"
+	if got := err.Error(); got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,232 @@
+package ctags
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+)
+
+// goParser extracts symbols from Go source with go/parser. Kinds follow the
+// Go kinds of universal-ctags, so symbols look the same regardless of the
+// parser.
+type goParser struct{}
+
+// NewGoParser returns an in-process parser for Go source. It does not need a
+// ctags binary and is safe for concurrent use.
+func NewGoParser() (Parser, error) {
+	return goParser{}, nil
+}
+
+func (goParser) Parse(name string, content []byte) ([]*Entry, error) {
+	g, _ := parseGo(name, content)
+	if g == nil {
+		return nil, nil
+	}
+	return g.entries, nil
+}
+
+// ParseAll implements ReferenceParser. References are identifiers which
+// resolve to package-level declarations or imports, and the names selected
+// from them, e.g. fields and methods. Definitions, locals, parameters and
+// predeclared identifiers are not references, nor are comments and strings.
+func (goParser) ParseAll(name string, content []byte) ([]*Entry, []Reference, error) {
+	g, f := parseGo(name, content)
+	if g == nil {
+		return nil, nil, nil
+	}
+
+	// Identifiers which are not declared in this file, i.e. imports,
+	// predeclared identifiers and declarations in other files of the package.
+	unresolved := make(map[*ast.Ident]bool, len(f.Unresolved))
+	for _, id := range f.Unresolved {
+		unresolved[id] = true
+	}
+	sels := map[*ast.Ident]bool{}
+
+	isRef := func(id *ast.Ident) bool {
+		switch {
+		case id.Name == "_" || g.defs[id]:
+			return false
+		case sels[id]:
+			return true
+		case id.Obj != nil:
+			return f.Scope.Lookup(id.Name) == id.Obj
+		case unresolved[id]:
+			return types.Universe.Lookup(id.Name) == nil
+		}
+		return false
+	}
+
+	var refs []Reference
+	ast.Inspect(f, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.ImportSpec:
+			return false
+		case *ast.SelectorExpr:
+			sels[n.Sel] = true
+		case *ast.Ident:
+			if isRef(n) {
+				refs = append(refs, Reference{
+					Name:   n.Name,
+					Offset: uint32(g.fset.Position(n.Pos()).Offset),
+				})
+			}
+		}
+		return true
+	})
+	return g.entries, refs, nil
+}
+
+// parseGo parses content and collects its definitions. It returns nil if
+// content is not Go source.
+func parseGo(name string, content []byte) (*goEntries, *ast.File) {
+	fset := token.NewFileSet()
+	// Like ctags we are best-effort on files with syntax errors and use
+	// whatever could be parsed. Object resolution tells references to
+	// package-level declarations apart from locals.
+	f, _ := parser.ParseFile(fset, name, content, 0)
+	if f == nil || f.Name == nil {
+		return nil, nil
+	}
+
+	g := &goEntries{
+		fset:      fset,
+		path:      name,
+		typeKinds: map[string]string{},
+		defs:      map[*ast.Ident]bool{},
+	}
+
+	// Methods can be declared before their receiver type, so we first collect
+	// the kinds of all types to set ParentKind.
+	for _, decl := range f.Decls {
+		if gd, ok := decl.(*ast.GenDecl); ok && gd.Tok == token.TYPE {
+			for _, spec := range gd.Specs {
+				ts := spec.(*ast.TypeSpec)
+				g.typeKinds[ts.Name.Name] = goTypeKind(ts)
+			}
+		}
+	}
+
+	g.add(f.Name, "package", "", "")
+	for _, decl := range f.Decls {
+		switch d := decl.(type) {
+		case *ast.FuncDecl:
+			if d.Recv == nil || len(d.Recv.List) == 0 {
+				g.add(d.Name, "func", "", "")
+				continue
+			}
+			recv := goReceiverName(d.Recv.List[0].Type)
+			kind, ok := g.typeKinds[recv]
+			if !ok {
+				kind = "unknown"
+			}
+			g.add(d.Name, "func", recv, kind)
+
+		case *ast.GenDecl:
+			g.addGenDecl(d)
+		}
+	}
+	return g, f
+}
+
+func (goParser) Close() {}
+
+type goEntries struct {
+	fset      *token.FileSet
+	path      string
+	typeKinds map[string]string
+	entries   []*Entry
+
+	// defs are the identifiers of entries.
+	defs map[*ast.Ident]bool
+}
+
+func (g *goEntries) add(id *ast.Ident, kind, parent, parentKind string) {
+	if id == nil || id.Name == "_" {
+		return
+	}
+	g.defs[id] = true
+	g.entries = append(g.entries, &Entry{
+		Name:       id.Name,
+		Path:       g.path,
+		Line:       g.fset.Position(id.Pos()).Line,
+		Kind:       kind,
+		Language:   "Go",
+		Parent:     parent,
+		ParentKind: parentKind,
+	})
+}
+
+func (g *goEntries) addGenDecl(d *ast.GenDecl) {
+	for _, spec := range d.Specs {
+		switch s := spec.(type) {
+		case *ast.ValueSpec:
+			kind := "var"
+			if d.Tok == token.CONST {
+				kind = "const"
+			}
+			for _, name := range s.Names {
+				g.add(name, kind, "", "")
+			}
+
+		case *ast.TypeSpec:
+			kind := g.typeKinds[s.Name.Name]
+			g.add(s.Name, kind, "", "")
+
+			switch t := s.Type.(type) {
+			case *ast.StructType:
+				g.addFields(t.Fields, "member", s.Name.Name, kind)
+			case *ast.InterfaceType:
+				g.addFields(t.Methods, "methodSpec", s.Name.Name, kind)
+			}
+		}
+	}
+}
+
+// addFields adds the named fields of a struct or the methods of an interface.
+// Embedded types are skipped.
+func (g *goEntries) addFields(fields *ast.FieldList, kind, parent, parentKind string) {
+	if fields == nil {
+		return
+	}
+	for _, field := range fields.List {
+		for _, name := range field.Names {
+			g.add(name, kind, parent, parentKind)
+		}
+	}
+}
+
+func goTypeKind(ts *ast.TypeSpec) string {
+	if ts.Assign.IsValid() {
+		return "talias"
+	}
+	switch ts.Type.(type) {
+	case *ast.StructType:
+		return "struct"
+	case *ast.InterfaceType:
+		return "interface"
+	}
+	return "type"
+}
+
+// goReceiverName returns the type name of a method receiver, e.g. "T" for
+// "*T" or "T[K]".
+func goReceiverName(expr ast.Expr) string {
+	for {
+		switch e := expr.(type) {
+		case *ast.StarExpr:
+			expr = e.X
+		case *ast.ParenExpr:
+			expr = e.X
+		case *ast.IndexExpr:
+			expr = e.X
+		case *ast.IndexListExpr:
+			expr = e.X
+		case *ast.Ident:
+			return e.Name
+		default:
+			return ""
+		}
+	}
+}
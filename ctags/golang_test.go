@@ -0,0 +1,160 @@
+package ctags
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestGoParser(t *testing.T) {
+	p, err := NewGoParser()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer p.Close()
+
+	src := `package zoekt
+
+// Methods may be declared before their receiver.
+func (s *Searcher[T]) Search() {}
+
+type Searcher[T any] struct {
+	Name, Path string
+	embedded
+}
+
+type Sender interface {
+	Send()
+}
+
+type ID = uint32
+
+type count int
+
+const (
+	Max = 10
+	_   = 0
+)
+
+var debug bool
+
+func New() *Searcher[int] { return nil }
+
+func (c count) String() string { return "" }
+
+func (x unknownType) Method() {}
+`
+	got, err := p.Parse("zoekt.go", []byte(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []*Entry{
+		{Name: "zoekt", Line: 1, Kind: "package"},
+		{Name: "Search", Line: 4, Kind: "func", Parent: "Searcher", ParentKind: "struct"},
+		{Name: "Searcher", Line: 6, Kind: "struct"},
+		{Name: "Name", Line: 7, Kind: "member", Parent: "Searcher", ParentKind: "struct"},
+		{Name: "Path", Line: 7, Kind: "member", Parent: "Searcher", ParentKind: "struct"},
+		{Name: "Sender", Line: 11, Kind: "interface"},
+		{Name: "Send", Line: 12, Kind: "methodSpec", Parent: "Sender", ParentKind: "interface"},
+		{Name: "ID", Line: 15, Kind: "talias"},
+		{Name: "count", Line: 17, Kind: "type"},
+		{Name: "Max", Line: 20, Kind: "const"},
+		{Name: "debug", Line: 24, Kind: "var"},
+		{Name: "New", Line: 26, Kind: "func"},
+		{Name: "String", Line: 28, Kind: "func", Parent: "count", ParentKind: "type"},
+		{Name: "Method", Line: 30, Kind: "func", Parent: "unknownType", ParentKind: "unknown"},
+	}
+	for _, e := range want {
+		e.Path = "zoekt.go"
+		e.Language = "Go"
+	}
+
+	if d := cmp.Diff(want, got); d != "" {
+		t.Errorf("mismatch (-want +got):\n%s", d)
+	}
+}
+
+func TestGoParserReferences(t *testing.T) {
+	p, _ := NewGoParser()
+	src := `package main
+
+import "fmt"
+
+type Config struct{ Name string }
+
+// ParseConfig in a comment is not a reference.
+func ParseConfig(s string) (*Config, error) { return nil, nil }
+
+func main() {
+	c, err := ParseConfig("ParseConfig")
+	fmt.Println(c.Name, err, Other)
+}
+`
+	entries, refs, err := p.(ReferenceParser).ParseAll("main.go", []byte(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 5 {
+		t.Errorf("got %d entries, want 5", len(entries))
+	}
+
+	var got []string
+	for _, r := range refs {
+		if src[r.Offset:int(r.Offset)+len(r.Name)] != r.Name {
+			t.Errorf("reference %q has wrong offset %d", r.Name, r.Offset)
+		}
+		got = append(got, r.Name)
+	}
+	want := []string{"Config", "ParseConfig", "fmt", "Println", "Name", "Other"}
+	if d := cmp.Diff(want, got); d != "" {
+		t.Errorf("mismatch (-want +got):\n%s", d)
+	}
+}
+
+func TestGoParserSyntaxError(t *testing.T) {
+	p, _ := NewGoParser()
+	got, err := p.Parse("broken.go", []byte("package broken\n\nfunc Valid() {}\n\nfunc {\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, e := range got {
+		names = append(names, e.Name)
+	}
+	if d := cmp.Diff([]string{"broken", "Valid"}, names); d != "" {
+		t.Errorf("mismatch (-want +got):\n%s", d)
+	}
+}
+
+func TestRegister(t *testing.T) {
+	if got := StringToParser("go"); got != GoCTags {
+		t.Fatalf("StringToParser(go) = %d, want GoCTags", got)
+	}
+
+	typ := Register("test-parser", NewGoParser)
+	if got := StringToParser("test-parser"); got != typ {
+		t.Errorf("StringToParser = %d, want %d", got, typ)
+	}
+	if got := ParserToString(typ); got != "test-parser" {
+		t.Errorf("ParserToString = %q, want test-parser", got)
+	}
+	if !IsInProcess(typ) || IsInProcess(UniversalCTags) {
+		t.Error("IsInProcess mismatch")
+	}
+
+	parsers, err := NewParserMap(ParserBinMap{}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if parsers[typ] == nil || parsers[GoCTags] == nil {
+		t.Errorf("in-process parsers missing from %v", parsers)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected Register to panic for a duplicate name")
+		}
+	}()
+	Register("test-parser", NewGoParser)
+}
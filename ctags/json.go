@@ -32,6 +32,22 @@ const debug = false
 type Parser = goctags.Parser
 type Entry = goctags.Entry
 
+// Reference is an occurrence of an identifier which refers to a symbol, e.g.
+// a call, as opposed to its definition.
+type Reference struct {
+	Name string
+
+	// Offset is the byte offset of Name in the parsed content.
+	Offset uint32
+}
+
+// ReferenceParser is implemented by parsers which can also extract
+// references. ParseAll returns the entries of Parse together with the
+// references, so content is only parsed once.
+type ReferenceParser interface {
+	ParseAll(path string, content []byte) ([]*Entry, []Reference, error)
+}
+
 type parseReq struct {
 	Name    string
 	Content []byte
@@ -25,6 +25,9 @@ const (
 	NoCTags
 	UniversalCTags
 	ScipCTags
+
+	// GoCTags is the built-in in-process parser for Go, see NewGoParser.
+	GoCTags
 )
 
 type LanguageMap = map[string]CTagsParserType
@@ -40,6 +43,9 @@ func ParserToString(parser CTagsParserType) string {
 	case ScipCTags:
 		return "scip"
 	default:
+		if r, ok := lookupRegistered(parser); ok {
+			return r.name
+		}
 		panic("Reached impossible CTagsParserType state")
 	}
 }
@@ -53,6 +59,9 @@ func StringToParser(str string) CTagsParserType {
 	case "scip":
 		return ScipCTags
 	default:
+		if typ, ok := lookupRegisteredName(str); ok {
+			return typ
+		}
 		return UniversalCTags
 	}
 }
@@ -76,5 +85,18 @@ func NewParserMap(bins ParserBinMap, cTagsMustSucceed bool) (ParserMap, error) {
 		}
 	}
 
+	// In-process parsers don't depend on a binary, so they are always
+	// available.
+	for parserType, r := range registered() {
+		parser, err := r.newParser()
+		if err != nil {
+			if cTagsMustSucceed {
+				return nil, fmt.Errorf("ctags.NewParserMap: %s: %v", r.name, err)
+			}
+			continue
+		}
+		parsers[parserType] = parser
+	}
+
 	return parsers, nil
 }
@@ -0,0 +1,97 @@
+package ctags
+
+import (
+	"fmt"
+	"math"
+	"sync"
+)
+
+// registeredParser is a parser implemented in-process by Go code.
+type registeredParser struct {
+	name      string
+	newParser func() (Parser, error)
+}
+
+var registry = struct {
+	mu     sync.Mutex
+	types  map[CTagsParserType]registeredParser
+	byName map[string]CTagsParserType
+	next   CTagsParserType
+}{
+	types:  map[CTagsParserType]registeredParser{},
+	byName: map[string]CTagsParserType{},
+	next:   GoCTags + 1,
+}
+
+func init() {
+	register(GoCTags, "go", NewGoParser)
+}
+
+// Register makes an in-process parser available under name, which can be
+// used in place of a ctags binary. newParser is called once per ParserMap and
+// the returned parser must be safe for concurrent use.
+//
+// Register returns the type to use for the parser in a LanguageMap. The name
+// can be used wherever a parser is specified as a string, see
+// StringToParser. Register panics if name is already in use, so it is
+// typically called from an init function.
+func Register(name string, newParser func() (Parser, error)) CTagsParserType {
+	registry.mu.Lock()
+	typ := registry.next
+	if typ == math.MaxUint8 {
+		registry.mu.Unlock()
+		panic("ctags: too many registered parsers")
+	}
+	registry.next++
+	registry.mu.Unlock()
+
+	register(typ, name, newParser)
+	return typ
+}
+
+func register(typ CTagsParserType, name string, newParser func() (Parser, error)) {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	switch name {
+	case "", "unknown", "no", "universal", "scip":
+		panic(fmt.Sprintf("ctags: invalid parser name %q", name))
+	}
+	if _, ok := registry.byName[name]; ok {
+		panic(fmt.Sprintf("ctags: Register called twice for parser %q", name))
+	}
+	registry.types[typ] = registeredParser{name: name, newParser: newParser}
+	registry.byName[name] = typ
+}
+
+// IsInProcess returns true if parser is implemented in-process, i.e. it is
+// available without a ctags binary.
+func IsInProcess(parser CTagsParserType) bool {
+	_, ok := lookupRegistered(parser)
+	return ok
+}
+
+func lookupRegistered(parser CTagsParserType) (registeredParser, bool) {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+	r, ok := registry.types[parser]
+	return r, ok
+}
+
+func lookupRegisteredName(name string) (CTagsParserType, bool) {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+	typ, ok := registry.byName[name]
+	return typ, ok
+}
+
+// registered returns a copy of the registered parsers.
+func registered() map[CTagsParserType]registeredParser {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+	parsers := make(map[CTagsParserType]registeredParser, len(registry.types))
+	for typ, r := range registry.types {
+		parsers[typ] = r
+	}
+	return parsers
+}
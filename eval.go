@@ -96,6 +96,10 @@ func (d *indexData) simplify(in query.Q) query.Q {
 			return d.simplifyMultiRepo(q, func(repo *Repository) bool {
 				return r.Repos.Contains(repo.ID)
 			})
+		case *query.Author:
+			if d.fileAuthors == nil {
+				return &query.Const{Value: false}
+			}
 		case *query.Language:
 			_, has := d.metaData.LanguageMap[r.Language]
 			if !has && d.metaData.IndexFeatureVersion < 12 {
@@ -167,6 +171,12 @@ func (d *indexData) Search(ctx context.Context, q query.Q, opts *SearchOptions)
 	default:
 	}
 
+	// Decide before simplifying, which may fold a type:commit query away.
+	commits := wantsCommits(q)
+	if commits && d.fileKinds == nil {
+		return &res, nil
+	}
+
 	q = d.simplify(q)
 	if c, ok := q.(*query.Const); ok && !c.Value {
 		return &res, nil
@@ -183,6 +193,7 @@ func (d *indexData) Search(ctx context.Context, q query.Q, opts *SearchOptions)
 	if err != nil {
 		return nil, err
 	}
+	mt = d.commitFilter(mt, commits)
 
 	// Capture the costs of construction before pruning
 	updateMatchTreeStats(mt, &res.Stats)
@@ -299,6 +310,12 @@ nextFileMatch:
 			FileName:           string(d.fileName(nextDoc)),
 			Checksum:           d.getChecksum(nextDoc),
 			Language:           d.languageMap[d.getLanguage(nextDoc)],
+			Commit:             d.isCommit(nextDoc),
+			Author:             d.author(nextDoc),
+		}
+
+		if fileMatch.Encoding, err = d.readEncoding(nextDoc); err != nil {
+			return nil, err
 		}
 
 		if s := d.subRepos[nextDoc]; s > 0 {
@@ -312,6 +329,8 @@ nextFileMatch:
 			if idx := d.branchIndex(nextDoc); idx >= 0 {
 				fileMatch.Version = sr.Branches[idx].Version
 			}
+		} else if fileMatch.Commit {
+			fileMatch.Version = fileMatch.FileName
 		} else {
 			idx := d.branchIndex(nextDoc)
 			if idx >= 0 {
@@ -381,9 +400,9 @@ nextFileMatch:
 
 	for _, md := range d.repoMetaData {
 		r := md
-		addRepo(&res, &r)
+		addRepo(&res, &r, commits)
 		for _, v := range r.SubRepoMap {
-			addRepo(&res, v)
+			addRepo(&res, v, commits)
 		}
 	}
 
@@ -503,15 +522,21 @@ func (d *indexData) scoreFileUsingBM25(fileMatch *FileMatch, doc uint32, cands [
 	fileMatch.addKeywordScore(score, sumTf, L, opts.DebugScore)
 }
 
-func addRepo(res *SearchResult, repo *Repository) {
+// addRepo adds the URL templates of repo to res. Commit matches link to
+// the commit, so commits selects CommitURLTemplate and no line fragment.
+func addRepo(res *SearchResult, repo *Repository, commits bool) {
 	if res.RepoURLs == nil {
 		res.RepoURLs = map[string]string{}
 	}
-	res.RepoURLs[repo.Name] = repo.FileURLTemplate
-
 	if res.LineFragments == nil {
 		res.LineFragments = map[string]string{}
 	}
+	if commits {
+		res.RepoURLs[repo.Name] = repo.CommitURLTemplate
+		res.LineFragments[repo.Name] = ""
+		return
+	}
+	res.RepoURLs[repo.Name] = repo.FileURLTemplate
 	res.LineFragments[repo.Name] = repo.LineFragmentTemplate
 }
 
@@ -546,6 +571,9 @@ func gatherMatches(mt matchTree, known map[matchTree]bool, merge bool) []*candid
 		if smt, ok := mt.(*symbolRegexpMatchTree); ok {
 			cands = append(cands, smt.found...)
 		}
+		if rmt, ok := mt.(*referenceMatchTree); ok {
+			cands = append(cands, rmt.found...)
+		}
 	})
 
 	foundContentMatch := false
@@ -610,16 +638,7 @@ func gatherMatches(mt matchTree, known map[matchTree]bool, merge bool) []*candid
 }
 
 func (d *indexData) branchIndex(docID uint32) int {
-	mask := d.fileBranchMasks[docID]
-	idx := 0
-	for mask != 0 {
-		if mask&0x1 != 0 {
-			return idx
-		}
-		idx++
-		mask >>= 1
-	}
-	return -1
+	return d.fileBranches(docID).first()
 }
 
 // gatherBranches returns a list of branch names taking into account any branch
@@ -627,29 +646,24 @@ func (d *indexData) branchIndex(docID uint32) int {
 // branches containing the docID and matching the branch filter. Otherwise, it
 // returns all branches containing docID.
 func (d *indexData) gatherBranches(docID uint32, mt matchTree, known map[matchTree]bool) []string {
-	var mask uint64
+	var set branchSet
 	visitMatches(mt, known, func(mt matchTree) {
 		bq, ok := mt.(*branchQueryMatchTree)
 		if !ok {
 			return
 		}
 
-		mask = mask | bq.branchMask()
+		set = set.or(bq.branches())
 	})
 
-	if mask == 0 {
-		mask = d.fileBranchMasks[docID]
+	if set.empty() {
+		set = d.fileBranches(docID)
 	}
 
 	var branches []string
-	id := uint32(1)
 	branchNames := d.branchNames[d.repos[docID]]
-	for mask != 0 {
-		if mask&0x1 != 0 {
-			branches = append(branches, branchNames[uint(id)])
-		}
-		id <<= 1
-		mask >>= 1
+	for _, id := range set.ids() {
+		branches = append(branches, branchNames[id])
 	}
 
 	return branches
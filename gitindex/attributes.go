@@ -0,0 +1,170 @@
+package gitindex
+
+import (
+	"io"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/go-enry/go-enry/v2"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/format/gitattributes"
+	"github.com/go-git/go-git/v5/plumbing/object"
+
+	git "github.com/go-git/go-git/v5"
+
+	"github.com/sourcegraph/zoekt"
+)
+
+const gitattributesFile = ".gitattributes"
+
+// linguistAttributes are the attributes GitHub linguist uses to override its
+// heuristics, see
+// https://github.com/github-linguist/linguist/blob/master/docs/overrides.md.
+var linguistAttributes = []string{
+	"linguist-generated",
+	"linguist-vendored",
+	"linguist-documentation",
+	"linguist-language",
+}
+
+// attributesMatcher applies linguist attributes from .gitattributes files to
+// documents.
+type attributesMatcher struct {
+	m gitattributes.Matcher
+}
+
+// newAttributesMatcher reads the .gitattributes files in paths from tree.
+// paths which do not exist in tree are ignored. It returns nil if there are
+// no attributes.
+func newAttributesMatcher(tree *object.Tree, paths []string) (*attributesMatcher, error) {
+	// The matcher wants the files in order of increasing priority, ie. files
+	// closer to the root first.
+	paths = append([]string(nil), paths...)
+	sort.Slice(paths, func(i, j int) bool {
+		di, dj := strings.Count(paths[i], "/"), strings.Count(paths[j], "/")
+		if di != dj {
+			return di < dj
+		}
+		return paths[i] < paths[j]
+	})
+
+	var stack []gitattributes.MatchAttribute
+	for _, p := range paths {
+		f, err := tree.File(p)
+		if err == object.ErrFileNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		content, err := f.Contents()
+		if err != nil {
+			return nil, err
+		}
+
+		var domain []string
+		if dir := path.Dir(p); dir != "." {
+			domain = strings.Split(dir, "/")
+		}
+		// Macros may only be defined in the root .gitattributes.
+		attrs, err := gitattributes.ReadAttributes(strings.NewReader(content), domain, len(domain) == 0)
+		if err != nil {
+			return nil, err
+		}
+		stack = append(stack, attrs...)
+	}
+
+	if len(stack) == 0 {
+		return nil, nil
+	}
+	return &attributesMatcher{m: gitattributes.NewMatcher(stack)}, nil
+}
+
+// loadAttributes reads all .gitattributes files from the first branch, which
+// is usually HEAD.
+func loadAttributes(repo *git.Repository, opts Options) (*attributesMatcher, error) {
+	branches := opts.BuildOptions.RepositoryDescription.Branches
+	if len(branches) == 0 {
+		return nil, nil
+	}
+	commit, err := repo.CommitObject(plumbing.NewHash(branches[0].Version))
+	if err != nil {
+		return nil, err
+	}
+	tree, err := commit.Tree()
+	if err != nil {
+		return nil, err
+	}
+
+	// The walker only reads trees, not the blobs of every file.
+	w := object.NewTreeWalker(tree, true, nil)
+	defer w.Close()
+	var paths []string
+	for {
+		name, entry, err := w.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if entry.Mode.IsFile() && isGitattributes(name) {
+			paths = append(paths, name)
+		}
+	}
+	return newAttributesMatcher(tree, paths)
+}
+
+// isGitattributes returns true if name is a .gitattributes file.
+func isGitattributes(name string) bool {
+	return path.Base(name) == gitattributesFile
+}
+
+// apply sets the overrides of the linguist attributes matching doc.Name.
+func (a *attributesMatcher) apply(doc *zoekt.Document) {
+	if a == nil {
+		return
+	}
+	attrs, matched := a.m.Match(strings.Split(doc.Name, "/"), linguistAttributes)
+	if !matched {
+		return
+	}
+
+	doc.Generated = linguistBool(attrs["linguist-generated"])
+	doc.Vendored = linguistBool(attrs["linguist-vendored"])
+	doc.Documentation = linguistBool(attrs["linguist-documentation"])
+
+	if attr, ok := attrs["linguist-language"]; ok && attr.IsValueSet() {
+		// linguist uses "-" instead of spaces in language names, eg.
+		// "linguist-language=Emacs-Lisp".
+		lang := strings.ReplaceAll(attr.Value(), "-", " ")
+		if canonical, ok := enry.GetLanguageByAlias(lang); ok {
+			doc.Language = canonical
+		} else if canonical, ok := enry.GetLanguageByAlias(attr.Value()); ok {
+			doc.Language = canonical
+		}
+	}
+}
+
+// linguistBool returns the value of a boolean linguist attribute, which is
+// true if it is set or "true" and false if it is unset or "false".
+func linguistBool(attr gitattributes.Attribute) *bool {
+	if attr == nil {
+		return nil
+	}
+	var v bool
+	switch {
+	case attr.IsSet():
+		v = true
+	case attr.IsUnset():
+		v = false
+	case attr.IsValueSet() && attr.Value() == "true":
+		v = true
+	case attr.IsValueSet() && attr.Value() == "false":
+		v = false
+	default:
+		return nil
+	}
+	return &v
+}
@@ -0,0 +1,133 @@
+package gitindex
+
+import (
+	"context"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	git "github.com/go-git/go-git/v5"
+
+	"github.com/sourcegraph/zoekt"
+	"github.com/sourcegraph/zoekt/build"
+	"github.com/sourcegraph/zoekt/query"
+	"github.com/sourcegraph/zoekt/shards"
+)
+
+func createAttributesRepo(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	script := `git init -b master repo
+cd repo
+git config user.email "you@example.com"
+git config user.name "Your Name"
+mkdir -p gen docs vendor sub
+cat > .gitattributes <<EOF
+*.tpl linguist-language=Go
+gen/** linguist-generated
+docs/** linguist-documentation
+vendor/** -linguist-vendored
+EOF
+echo '*.txt linguist-language=Markdown' > sub/.gitattributes
+echo 'func main() {}' > main.tpl
+echo 'generated' > gen/api.go
+echo 'docs' > docs/guide.go
+echo 'ours' > vendor/lib.go
+echo 'text' > sub/notes.txt
+echo 'text' > notes.txt
+git add -A
+git commit -m "initial"
+`
+	cmd := exec.Command("/bin/sh", "-euxc", script)
+	cmd.Dir = dir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("execution error: %v, output %s", err, out)
+	}
+	return filepath.Join(dir, "repo")
+}
+
+func TestAttributesMatcher(t *testing.T) {
+	repoDir := createAttributesRepo(t)
+	repo, err := git.PlainOpen(repoDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	head, err := repo.Head()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	opts := Options{}
+	opts.BuildOptions.RepositoryDescription.Branches = []zoekt.RepositoryBranch{{Name: "master", Version: head.Hash().String()}}
+	a, err := loadAttributes(repo, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	yes, no := true, false
+	for _, tc := range []struct {
+		name string
+		want zoekt.Document
+	}{
+		{"main.tpl", zoekt.Document{Language: "Go"}},
+		{"gen/api.go", zoekt.Document{Generated: &yes}},
+		{"docs/guide.go", zoekt.Document{Documentation: &yes}},
+		{"vendor/lib.go", zoekt.Document{Vendored: &no}},
+		{"sub/notes.txt", zoekt.Document{Language: "Markdown"}},
+		{"notes.txt", zoekt.Document{}},
+	} {
+		doc := zoekt.Document{Name: tc.name}
+		a.apply(&doc)
+		tc.want.Name = tc.name
+		if d := cmp.Diff(tc.want, doc); d != "" {
+			t.Errorf("%s: mismatch (-want +got):\n%s", tc.name, d)
+		}
+	}
+
+	if a, err := newAttributesMatcher(nil, nil); err != nil || a != nil {
+		t.Errorf("got %v, %v for no attributes, want nil", a, err)
+	}
+	var none *attributesMatcher
+	none.apply(&zoekt.Document{Name: "a.go"})
+}
+
+func TestIndexGitRepoAttributes(t *testing.T) {
+	indexDir := t.TempDir()
+	opts := Options{
+		RepoDir: createAttributesRepo(t),
+		BuildOptions: build.Options{
+			IndexDir: indexDir,
+			RepositoryDescription: zoekt.Repository{
+				Name: "repo",
+			},
+		},
+		BranchPrefix: "refs/heads",
+		Branches:     []string{"master"},
+	}
+	if err := IndexGitRepo(opts); err != nil {
+		t.Fatalf("IndexGitRepo: %v", err)
+	}
+
+	searcher, err := shards.NewDirectorySearcher(indexDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer searcher.Close()
+
+	res, err := searcher.Search(context.Background(), &query.Language{Language: "Go"}, &zoekt.SearchOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, f := range res.Files {
+		got = append(got, f.FileName)
+	}
+	// Documents are ordered by rank within the shard: the generated and
+	// documentation files are last, while vendor/lib.go is not vendored.
+	want := []string{"main.tpl", "vendor/lib.go", "docs/guide.go", "gen/api.go"}
+	if d := cmp.Diff(want, got); d != "" {
+		t.Errorf("mismatch (-want +got):\n%s", d)
+	}
+}
@@ -0,0 +1,195 @@
+package gitindex
+
+import (
+	"container/heap"
+	"fmt"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+
+	git "github.com/go-git/go-git/v5"
+
+	"github.com/sourcegraph/zoekt"
+)
+
+// fileAuthors returns the last author of the files in branchMap, see
+// zoekt.Document.Author. A file on several branches gets the author on the
+// first of its branches. Files of submodules are left out.
+func fileAuthors(repo *git.Repository, branches []zoekt.RepositoryBranch, branchMap map[fileKey][]string) (map[fileKey]string, error) {
+	// branch => paths
+	paths := map[string]map[string]bool{}
+	for key, brs := range branchMap {
+		if key.SubRepoPath != "" {
+			continue
+		}
+		for _, br := range brs {
+			if paths[br] == nil {
+				paths[br] = map[string]bool{}
+			}
+			paths[br][key.Path] = true
+		}
+	}
+
+	// branch => path => author
+	byBranch := map[string]map[string]string{}
+	for _, br := range branches {
+		if len(paths[br.Name]) == 0 {
+			continue
+		}
+		authors, err := lastAuthors(repo, plumbing.NewHash(br.Version), paths[br.Name])
+		if err != nil {
+			return nil, fmt.Errorf("branch %s: %w", br.Name, err)
+		}
+		byBranch[br.Name] = authors
+	}
+
+	result := map[fileKey]string{}
+	for key, brs := range branchMap {
+		for _, br := range brs {
+			if a, ok := byBranch[br][key.Path]; ok {
+				result[key] = a
+				break
+			}
+		}
+	}
+	return result, nil
+}
+
+// maxAuthorCommits bounds the history lastAuthors walks. Files which were
+// last changed before are left without an author.
+const maxAuthorCommits = 10000
+
+// lastAuthors returns the author of the last commit touching each of paths
+// in the history of head. Like git log -- path, a path is followed into the
+// first parent of a merge it is unchanged from, so changes are attributed to
+// the commits which made them rather than to the merge. A merge is only the
+// last commit touching a path which differs from all of its parents, eg. a
+// conflict resolution. The walk stops as soon as all paths are attributed,
+// or after maxAuthorCommits commits.
+func lastAuthors(repo *git.Repository, head plumbing.Hash, paths map[string]bool) (map[string]string, error) {
+	c, err := repo.CommitObject(head)
+	if err != nil {
+		return nil, err
+	}
+
+	authors := make(map[string]string, len(paths))
+
+	// The paths to attribute in the history of each commit to visit. Commits
+	// are visited newest first, and a commit reached through several children
+	// is visited once for all of them if possible.
+	start := &authorWalkItem{commit: c, paths: make(map[string]bool, len(paths))}
+	for p := range paths {
+		start.paths[p] = true
+	}
+	pending := map[plumbing.Hash]*authorWalkItem{head: start}
+	queue := authorWalkQueue{start}
+
+	for i := 0; i < maxAuthorCommits && queue.Len() > 0 && len(authors) < len(paths); i++ {
+		item := heap.Pop(&queue).(*authorWalkItem)
+		delete(pending, item.commit.Hash)
+		c := item.commit
+
+		tree, err := c.Tree()
+		if err != nil {
+			return nil, err
+		}
+		var parents []*object.Commit
+		if err := c.Parents().ForEach(func(p *object.Commit) error {
+			parents = append(parents, p)
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+		// The root commit differs from its empty parent in all paths.
+		changed := []map[string]bool{nil}
+		if len(parents) > 0 {
+			changed = make([]map[string]bool, len(parents))
+			for j, parent := range parents {
+				parentTree, err := parent.Tree()
+				if err != nil {
+					return nil, err
+				}
+				if changed[j], err = diffPaths(parentTree, tree); err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		for p := range item.paths {
+			if _, ok := authors[p]; ok {
+				continue
+			}
+			same := -1
+			for j := range parents {
+				if !changed[j][p] {
+					same = j
+					break
+				}
+			}
+			if same < 0 {
+				authors[p] = fmt.Sprintf("%s <%s>", c.Author.Name, c.Author.Email)
+				continue
+			}
+
+			parent := parents[same]
+			next, ok := pending[parent.Hash]
+			if !ok {
+				next = &authorWalkItem{commit: parent, paths: map[string]bool{}}
+				pending[parent.Hash] = next
+				heap.Push(&queue, next)
+			}
+			next.paths[p] = true
+		}
+	}
+	return authors, nil
+}
+
+// diffPaths returns the paths which differ between from and to.
+func diffPaths(from, to *object.Tree) (map[string]bool, error) {
+	changes, err := object.DiffTree(from, to)
+	if err != nil {
+		return nil, err
+	}
+	paths := make(map[string]bool, len(changes))
+	for _, ch := range changes {
+		if ch.From.Name != "" {
+			paths[ch.From.Name] = true
+		}
+		if ch.To.Name != "" {
+			paths[ch.To.Name] = true
+		}
+	}
+	return paths, nil
+}
+
+// authorWalkItem is a commit to visit in lastAuthors.
+type authorWalkItem struct {
+	commit *object.Commit
+
+	// paths are attributed in the history of commit.
+	paths map[string]bool
+}
+
+// authorWalkQueue is a heap of commits, newest first.
+type authorWalkQueue []*authorWalkItem
+
+func (q authorWalkQueue) Len() int { return len(q) }
+
+func (q authorWalkQueue) Less(i, j int) bool {
+	ti, tj := q[i].commit.Committer.When, q[j].commit.Committer.When
+	if !ti.Equal(tj) {
+		return ti.After(tj)
+	}
+	return q[i].commit.Hash.String() < q[j].commit.Hash.String()
+}
+
+func (q authorWalkQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+
+func (q *authorWalkQueue) Push(x interface{}) { *q = append(*q, x.(*authorWalkItem)) }
+
+func (q *authorWalkQueue) Pop() interface{} {
+	old := *q
+	item := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return item
+}
@@ -0,0 +1,130 @@
+package gitindex
+
+import (
+	"context"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/zoekt"
+	"github.com/sourcegraph/zoekt/build"
+	"github.com/sourcegraph/zoekt/query"
+	"github.com/sourcegraph/zoekt/shards"
+)
+
+func TestIndexGitRepoAuthors(t *testing.T) {
+	dir := t.TempDir()
+	script := `git init -b master repo
+cd repo
+git config user.email "alice@example.com"
+git config user.name "Alice"
+echo one > one.txt
+echo two > two.txt
+echo three > three.txt
+git add -A
+git commit -m "initial"
+git config user.email "bob@example.com"
+git config user.name "Bob"
+echo one again > one.txt
+git add -A
+git commit -m "change one"
+git checkout -b feature
+git config user.email "carol@example.com"
+git config user.name "Carol"
+echo two again > two.txt
+echo three carol > three.txt
+git add -A
+git commit -m "change two and three"
+git checkout master
+git config user.email "bob@example.com"
+git config user.name "Bob"
+echo three bob > three.txt
+git add -A
+git commit -m "change three"
+git config user.email "dave@example.com"
+git config user.name "Dave"
+git merge --no-ff -m "merge feature" feature || true
+echo three again > three.txt
+git add -A
+git commit --no-edit
+`
+	cmd := exec.Command("/bin/sh", "-euxc", script)
+	cmd.Dir = dir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("execution error: %v, output %s", err, out)
+	}
+
+	indexDir := t.TempDir()
+	opts := Options{
+		RepoDir: filepath.Join(dir, "repo"),
+		BuildOptions: build.Options{
+			IndexDir:     indexDir,
+			IndexAuthors: true,
+			RepositoryDescription: zoekt.Repository{
+				Name: "repo",
+			},
+		},
+		BranchPrefix: "refs/heads",
+		Branches:     []string{"master"},
+	}
+	if err := IndexGitRepo(opts); err != nil {
+		t.Fatalf("IndexGitRepo: %v", err)
+	}
+
+	searcher, err := shards.NewDirectorySearcher(indexDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer searcher.Close()
+
+	search := func(q string) map[string]string {
+		t.Helper()
+		parsed, err := query.Parse(q)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res, err := searcher.Search(context.Background(), parsed, &zoekt.SearchOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := map[string]string{}
+		for _, f := range res.Files {
+			got[f.FileName] = f.Author
+		}
+		return got
+	}
+
+	// The merge is only the last change of three.txt, whose conflict it
+	// resolved.
+	want := map[string]string{
+		"one.txt":   "Bob <bob@example.com>",
+		"two.txt":   "Carol <carol@example.com>",
+		"three.txt": "Dave <dave@example.com>",
+	}
+	if d := cmp.Diff(want, search("again")); d != "" {
+		t.Errorf("authors mismatch (-want +got):\n%s", d)
+	}
+
+	for q, wantFiles := range map[string][]string{
+		"author:Bob":             {"one.txt"},
+		"author:carol@example":   {"two.txt"},
+		"author:Dave":            {"three.txt"},
+		"author:example.com":     {"one.txt", "three.txt", "two.txt"},
+		"author:Alice":           nil,
+		"again -author:Carol":    {"one.txt", "three.txt"},
+		"author:Bob f:two.txt":   nil,
+		"author:Carol f:two.txt": {"two.txt"},
+	} {
+		var files []string
+		for f := range search(q) {
+			files = append(files, f)
+		}
+		sort.Strings(files)
+		if d := cmp.Diff(wantFiles, files); d != "" {
+			t.Errorf("%s: mismatch (-want +got):\n%s", q, d)
+		}
+	}
+}
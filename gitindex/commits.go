@@ -0,0 +1,132 @@
+package gitindex
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/storer"
+
+	git "github.com/go-git/go-git/v5"
+
+	"github.com/sourcegraph/zoekt"
+)
+
+// maxCommitPaths caps the number of changed paths listed in a commit
+// document, so that large merges or imports don't produce huge documents.
+const maxCommitPaths = 1000
+
+// commitDocuments returns a commit document for each of the last n commits
+// of branches, see zoekt.Document.Commit. A commit which is on several
+// branches yields a single document with all of them.
+func commitDocuments(repo *git.Repository, branches []zoekt.RepositoryBranch, n int) ([]zoekt.Document, error) {
+	var docs []*zoekt.Document
+	bySHA := map[plumbing.Hash]*zoekt.Document{}
+	for _, br := range branches {
+		iter, err := repo.Log(&git.LogOptions{
+			From:  plumbing.NewHash(br.Version),
+			Order: git.LogOrderCommitterTime,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("git log %s: %w", br.Name, err)
+		}
+
+		count := 0
+		err = iter.ForEach(func(c *object.Commit) error {
+			if count >= n {
+				return storer.ErrStop
+			}
+			count++
+
+			if doc, ok := bySHA[c.Hash]; ok {
+				doc.Branches = append(doc.Branches, br.Name)
+				return nil
+			}
+			content, err := commitContent(c)
+			if err != nil {
+				return err
+			}
+			doc := &zoekt.Document{
+				Name:     c.Hash.String(),
+				Content:  content,
+				Branches: []string{br.Name},
+				Commit:   true,
+			}
+			bySHA[c.Hash] = doc
+			docs = append(docs, doc)
+			return nil
+		})
+		iter.Close()
+		if err != nil {
+			return nil, fmt.Errorf("git log %s: %w", br.Name, err)
+		}
+	}
+
+	result := make([]zoekt.Document, 0, len(docs))
+	for _, doc := range docs {
+		result = append(result, *doc)
+	}
+	return result, nil
+}
+
+// commitContent formats c like git log --name-only: the hash, author, date
+// and message, followed by the paths changed relative to the first parent.
+func commitContent(c *object.Commit) ([]byte, error) {
+	var b strings.Builder
+	fmt.Fprintf(&b, "commit %s\n", c.Hash)
+	fmt.Fprintf(&b, "Author: %s <%s>\n", c.Author.Name, c.Author.Email)
+	fmt.Fprintf(&b, "Date:   %s\n\n", c.Author.When.Format(time.RFC3339))
+	for _, line := range strings.Split(strings.TrimRight(c.Message, "\n"), "\n") {
+		fmt.Fprintf(&b, "    %s\n", line)
+	}
+
+	paths, err := changedPaths(c)
+	if err != nil {
+		return nil, err
+	}
+	if len(paths) > maxCommitPaths {
+		paths = paths[:maxCommitPaths]
+	}
+	if len(paths) > 0 {
+		b.WriteString("\n")
+	}
+	for _, p := range paths {
+		fmt.Fprintf(&b, "%s\n", p)
+	}
+	return []byte(b.String()), nil
+}
+
+// changedPaths returns the paths changed by c relative to its first parent,
+// or all paths of a root commit.
+func changedPaths(c *object.Commit) ([]string, error) {
+	tree, err := c.Tree()
+	if err != nil {
+		return nil, err
+	}
+	var parentTree *object.Tree
+	if c.NumParents() > 0 {
+		parent, err := c.Parent(0)
+		if err != nil {
+			return nil, err
+		}
+		if parentTree, err = parent.Tree(); err != nil {
+			return nil, err
+		}
+	}
+
+	changes, err := object.DiffTree(parentTree, tree)
+	if err != nil {
+		return nil, err
+	}
+	var paths []string
+	for _, ch := range changes {
+		name := ch.To.Name
+		if name == "" {
+			name = ch.From.Name
+		}
+		paths = append(paths, name)
+	}
+	return paths, nil
+}
@@ -0,0 +1,141 @@
+package gitindex
+
+import (
+	"context"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/zoekt"
+	"github.com/sourcegraph/zoekt/build"
+	"github.com/sourcegraph/zoekt/query"
+	"github.com/sourcegraph/zoekt/shards"
+)
+
+func TestIndexGitRepoCommits(t *testing.T) {
+	dir := t.TempDir()
+	script := `git init -b master repo
+cd repo
+git config user.email "you@example.com"
+git config user.name "Your Name"
+echo one > one.txt
+git add -A
+git commit -m "add the first file"
+echo two > two.txt
+git add -A
+git commit -m "add the second file"
+git checkout -b feature
+echo three > three.txt
+git rm one.txt
+git add -A
+git commit -m "replace the first file"
+`
+	cmd := exec.Command("/bin/sh", "-euxc", script)
+	cmd.Dir = dir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("execution error: %v, output %s", err, out)
+	}
+
+	indexDir := t.TempDir()
+	opts := Options{
+		RepoDir: filepath.Join(dir, "repo"),
+		BuildOptions: build.Options{
+			IndexDir:     indexDir,
+			IndexCommits: 2,
+			RepositoryDescription: zoekt.Repository{
+				Name:              "repo",
+				CommitURLTemplate: "https://example.com/repo/commit/{{.Version}}",
+			},
+		},
+		BranchPrefix: "refs/heads",
+		Branches:     []string{"master", "feature"},
+	}
+	if err := IndexGitRepo(opts); err != nil {
+		t.Fatalf("IndexGitRepo: %v", err)
+	}
+
+	searcher, err := shards.NewDirectorySearcher(indexDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer searcher.Close()
+
+	search := func(q string) *zoekt.SearchResult {
+		t.Helper()
+		parsed, err := query.Parse(q)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res, err := searcher.Search(context.Background(), parsed, &zoekt.SearchOptions{Whole: true})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return res
+	}
+
+	// Commits are not returned for normal queries.
+	var files []string
+	for _, f := range search("file").Files {
+		if f.Commit {
+			t.Errorf("got commit %s for a normal query", f.FileName)
+		}
+		files = append(files, f.FileName)
+	}
+	if len(files) != 0 {
+		t.Errorf("got files %v for content only in commit messages", files)
+	}
+
+	type commit struct {
+		Subject  string
+		Branches []string
+	}
+	commits := func(q string) []commit {
+		t.Helper()
+		res := search(q)
+		var got []commit
+		for _, f := range res.Files {
+			if !f.Commit || f.Version != f.FileName {
+				t.Errorf("%s: got file match %+v, want a commit", q, f)
+			}
+			_, message, _ := strings.Cut(string(f.Content), "\n\n")
+			subject, _, _ := strings.Cut(strings.TrimSpace(message), "\n")
+			branches := append([]string(nil), f.Branches...)
+			sort.Strings(branches)
+			got = append(got, commit{Subject: subject, Branches: branches})
+		}
+		sort.Slice(got, func(i, j int) bool { return got[i].Subject < got[j].Subject })
+		if tpl := res.RepoURLs["repo"]; tpl != opts.BuildOptions.RepositoryDescription.CommitURLTemplate {
+			t.Errorf("%s: got URL template %q, want the commit URL template", q, tpl)
+		}
+		return got
+	}
+
+	// Only the last two commits of each branch are indexed.
+	want := []commit{
+		{Subject: "add the first file", Branches: []string{"master"}},
+		{Subject: "add the second file", Branches: []string{"feature", "master"}},
+		{Subject: "replace the first file", Branches: []string{"feature"}},
+	}
+	feature := []commit{
+		{Subject: "add the second file", Branches: []string{"feature"}},
+		want[2],
+	}
+	if d := cmp.Diff(want, commits("type:commit file")); d != "" {
+		t.Errorf("type:commit mismatch (-want +got):\n%s", d)
+	}
+	if d := cmp.Diff([]commit{want[0], want[2]}, commits("commit:one.txt")); d != "" {
+		t.Errorf("commit: mismatch (-want +got):\n%s", d)
+	}
+	if d := cmp.Diff(feature, commits("type:commit branch:feature")); d != "" {
+		t.Errorf("all commits mismatch (-want +got):\n%s", d)
+	}
+
+	// Files are still found.
+	if res := search("three"); len(res.Files) != 1 || res.Files[0].FileName != "three.txt" {
+		t.Errorf("got %+v, want three.txt", res.Files)
+	}
+}
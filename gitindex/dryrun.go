@@ -0,0 +1,111 @@
+package gitindex
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"github.com/go-git/go-git/v5/plumbing/object"
+
+	git "github.com/go-git/go-git/v5"
+
+	"github.com/sourcegraph/zoekt/build"
+	"github.com/sourcegraph/zoekt/ignore"
+)
+
+// reportIgnored reports the files of the indexed branches which
+// prepareNormalBuild leaves out, so that a dry run covers every file of the
+// repository. Submodules are reported if their files are not indexed.
+func reportIgnored(builder *build.Builder, repository *git.Repository, opts Options) error {
+	var repoCache *RepoCache
+	if opts.Submodules {
+		repoCache = NewRepoCache(opts.RepoCacheDir)
+	}
+
+	seen := map[string]bool{}
+	for _, b := range opts.BuildOptions.RepositoryDescription.Branches {
+		commit, err := repository.CommitObject(plumbing.NewHash(b.Version))
+		if err != nil {
+			return err
+		}
+		tree, err := commit.Tree()
+		if err != nil {
+			return err
+		}
+		ig, err := newIgnoreMatcher(tree)
+		if err != nil {
+			return err
+		}
+
+		var rw *repoWalker
+		if repoCache != nil {
+			rw = newRepoWalker(repository, opts.BuildOptions.RepositoryDescription.URL, repoCache)
+			if err := rw.parseModuleMap(tree); err != nil {
+				return fmt.Errorf("parseModuleMap: %w", err)
+			}
+		}
+
+		if err := reportIgnoredTree(builder, tree, ig, rw, opts, seen); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// reportIgnoredTree reports the files of tree which are left out and not in
+// seen yet.
+func reportIgnoredTree(builder *build.Builder, tree *object.Tree, ig *ignore.Matcher, rw *repoWalker, opts Options, seen map[string]bool) error {
+	w := object.NewTreeWalker(tree, true, nil)
+	defer w.Close()
+	for {
+		name, entry, err := w.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if seen[name] {
+			continue
+		}
+
+		var reason string
+		switch {
+		case !entry.Mode.IsFile() && entry.Mode != filemode.Submodule:
+			continue
+		case ig.Match(name):
+			reason = "ignored by " + ignore.IgnoreFile
+		case opts.BuildOptions.IsExcluded(name):
+			reason = "excluded by pattern"
+		case entry.Mode == filemode.Submodule:
+			reason = submoduleSkipReason(rw, name, entry.Hash)
+		}
+		if reason == "" {
+			continue
+		}
+		seen[name] = true
+		if err := builder.Ignore(name, reason); err != nil {
+			return err
+		}
+	}
+}
+
+// submoduleSkipReason returns why the files of the submodule at path p are
+// not indexed, or "" if they are. rw is nil if submodules are not indexed.
+func submoduleSkipReason(rw *repoWalker, p string, id plumbing.Hash) string {
+	if rw == nil {
+		return "submodule not indexed (-submodules=false)"
+	}
+	if rw.submodules[p] == nil {
+		return "submodule not indexed: no entry in .gitmodules"
+	}
+	subRepo, _, err := rw.openSubmodule(p)
+	if err != nil {
+		return fmt.Sprintf("submodule repo not found in repo cache: %v", err)
+	}
+	if _, err := subRepo.CommitObject(id); err != nil {
+		return fmt.Sprintf("submodule commit %s not found in repo cache", id)
+	}
+	return ""
+}
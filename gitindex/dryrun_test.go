@@ -0,0 +1,83 @@
+package gitindex
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sourcegraph/zoekt/build"
+)
+
+// dryRun indexes opts in a dry run and returns the reported entries by name.
+func dryRun(t *testing.T, opts Options) map[string]build.DryRunEntry {
+	t.Helper()
+
+	// The builder reports to os.Stdout.
+	out, err := os.Create(filepath.Join(t.TempDir(), "out"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer out.Close()
+	stdout := os.Stdout
+	os.Stdout = out
+	err = IndexGitRepo(opts)
+	os.Stdout = stdout
+	if err != nil {
+		t.Fatalf("IndexGitRepo: %v", err)
+	}
+
+	if _, err := out.Seek(0, 0); err != nil {
+		t.Fatal(err)
+	}
+	entries := map[string]build.DryRunEntry{}
+	dec := json.NewDecoder(out)
+	for dec.More() {
+		var e build.DryRunEntry
+		if err := dec.Decode(&e); err != nil {
+			t.Fatal(err)
+		}
+		entries[e.Name] = e
+	}
+	return entries
+}
+
+func TestDryRunSubmodules(t *testing.T) {
+	dir := t.TempDir()
+	if err := createSubmoduleRepo(dir); err != nil {
+		t.Fatalf("createSubmoduleRepo: %v", err)
+	}
+
+	for _, tc := range []struct {
+		name         string
+		submodules   bool
+		repoCacheDir string
+		wantReason   string
+		wantIndexed  bool
+	}{
+		{name: "disabled", wantReason: "submodule not indexed (-submodules=false)"},
+		{name: "indexed", submodules: true, repoCacheDir: dir, wantIndexed: true},
+		{name: "not cached", submodules: true, repoCacheDir: t.TempDir(), wantReason: "submodule repo not found in repo cache: repository does not exist"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			entries := dryRun(t, Options{
+				RepoDir: filepath.Join(dir, "gerrit.googlesource.com", "adir.git"),
+				BuildOptions: build.Options{
+					IndexDir: t.TempDir(),
+					DryRun:   true,
+				},
+				BranchPrefix: "refs/heads/",
+				Branches:     []string{"master"},
+				Submodules:   tc.submodules,
+				RepoCacheDir: tc.repoCacheDir,
+			})
+
+			if got := entries["bname"].SkipReason; got != tc.wantReason {
+				t.Errorf("got skip reason %q for the submodule, want %q", got, tc.wantReason)
+			}
+			if got := entries["bname/bfile"].Indexed; got != tc.wantIndexed {
+				t.Errorf("got indexed %v for bname/bfile, want %v", got, tc.wantIndexed)
+			}
+		})
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"io/fs"
 	"log"
 	"math"
 	"net/url"
@@ -313,6 +314,19 @@ type Options struct {
 	// List of branch names to index, e.g. []string{"HEAD", "stable"}
 	Branches []string
 
+	// Glob patterns of tags to index in addition to Branches, e.g.
+	// []string{"v*"}. Tags are indexed like branches, so they can be
+	// searched with branch: queries.
+	Tags []string
+
+	// MaxTags limits the number of tags selected by Tags to the highest
+	// versions. If 0, all matching tags are indexed.
+	MaxTags int
+
+	// ObjectBackend selects how the files of the repository are read. The
+	// default is GoGitBackend.
+	ObjectBackend ObjectBackend
+
 	// DeltaShardNumberFallbackThreshold defines an upper limit (inclusive) on the number of preexisting shards
 	// that can exist before attempting another delta build. If the number of preexisting shards exceeds this threshold,
 	// then a normal build will be performed instead.
@@ -404,6 +418,26 @@ func indexGitRepo(opts Options, config gitIndexConfig) error {
 		log.Printf("setTemplatesFromConfig(%s): %s", opts.RepoDir, err)
 	}
 
+	repoConfig, err := loadRepoConfig(repo, opts)
+	if err != nil {
+		return fmt.Errorf("loadRepoConfig: %w", err)
+	}
+	repoConfig.apply(repo, &opts)
+
+	if len(opts.Tags) > 0 {
+		tags, err := expandTags(repo, opts.Tags, opts.MaxTags)
+		if err != nil {
+			return fmt.Errorf("expandTags: %w", err)
+		}
+		branches := opts.Branches[:len(opts.Branches):len(opts.Branches)]
+		for _, t := range tags {
+			if !contains(branches, t) {
+				branches = append(branches, t)
+			}
+		}
+		opts.Branches = branches
+	}
+
 	branches, err := expandBranches(repo, opts.Branches, opts.BranchPrefix)
 	if err != nil {
 		return fmt.Errorf("expandBranches: %w", err)
@@ -428,7 +462,7 @@ func indexGitRepo(opts Options, config gitIndexConfig) error {
 		}
 	}
 
-	if opts.Incremental && opts.BuildOptions.IncrementalSkipIndexing() {
+	if opts.Incremental && !opts.BuildOptions.DryRun && opts.BuildOptions.IncrementalSkipIndexing() {
 		return nil
 	}
 
@@ -447,6 +481,9 @@ func indexGitRepo(opts Options, config gitIndexConfig) error {
 	// These only have an effect on delta builds
 	var changedOrRemovedFiles []string
 
+	reader := newObjectReader(opts.ObjectBackend, repo, opts.RepoDir)
+	defer reader.Close()
+
 	if opts.BuildOptions.IsDelta {
 		repos, branchMap, branchVersions, changedOrRemovedFiles, err = prepareDeltaBuild(opts, repo)
 		if err != nil {
@@ -456,7 +493,7 @@ func indexGitRepo(opts Options, config gitIndexConfig) error {
 	}
 
 	if !opts.BuildOptions.IsDelta {
-		repos, branchMap, branchVersions, err = prepareNormalBuild(opts, repo)
+		repos, branchMap, branchVersions, err = prepareNormalBuild(opts, repo, reader)
 		if err != nil {
 			return fmt.Errorf("preparing normal build: %w", err)
 		}
@@ -527,6 +564,12 @@ func indexGitRepo(opts Options, config gitIndexConfig) error {
 		builder.MarkFileAsChangedOrRemoved(f)
 	}
 
+	if opts.BuildOptions.DryRun && !opts.BuildOptions.IsDelta {
+		if err := reportIgnored(builder, repo, opts); err != nil {
+			return fmt.Errorf("reportIgnored: %w", err)
+		}
+	}
+
 	var names []string
 	fileKeys := map[string][]fileKey{}
 	for key := range repos {
@@ -538,54 +581,121 @@ func indexGitRepo(opts Options, config gitIndexConfig) error {
 	sort.Strings(names)
 	names = uniq(names)
 
+	attributes, err := loadAttributes(repo, opts)
+	if err != nil {
+		return fmt.Errorf("loadAttributes: %w", err)
+	}
+
+	var authors map[fileKey]string
+	if opts.BuildOptions.IndexAuthors {
+		authors, err = fileAuthors(repo, opts.BuildOptions.RepositoryDescription.Branches, branchMap)
+		if err != nil {
+			return fmt.Errorf("fileAuthors: %w", err)
+		}
+	}
+
 	for _, name := range names {
 		keys := fileKeys[name]
 
 		for _, key := range keys {
 			brs := branchMap[key]
-			blob, err := repos[key].Repo.BlobObject(key.ID)
+			location := repos[key]
+			objects := reader
+			if location.Repo != repo {
+				// Submodules are always read with go-git.
+				objects = &goGitReader{repo: location.Repo}
+			}
+
+			size, err := objects.Size(key.ID)
 			if err != nil {
 				return err
 			}
 
 			keyFullPath := key.FullPath()
 
-			if blob.Size > int64(opts.BuildOptions.SizeMax) && !opts.BuildOptions.IgnoreSizeMax(keyFullPath) {
+			// Small blobs are read right away, since they could be Git LFS
+			// pointers.
+			var contents []byte
+			var lfs *lfsPointer
+			if size <= lfsPointerMaxSize {
+				contents, err = objects.Blob(key.ID)
+				if err != nil {
+					return err
+				}
+				if p, ok := parseLFSPointer(contents); ok {
+					lfs, size, contents = p, p.Size, nil
+				}
+			}
+
+			if size > int64(opts.BuildOptions.SizeMax) && !opts.BuildOptions.IgnoreSizeMax(keyFullPath) {
 				if err := builder.Add(zoekt.Document{
-					SkipReason:        fmt.Sprintf("file size %d exceeds maximum size %d", blob.Size, opts.BuildOptions.SizeMax),
+					SkipReason:        fmt.Sprintf("file size %d exceeds maximum size %d", size, opts.BuildOptions.SizeMax),
 					Name:              keyFullPath,
 					Branches:          brs,
 					SubRepositoryPath: key.SubRepoPath,
+					Size:              size,
 				}); err != nil {
 					return err
 				}
 				continue
 			}
 
-			contents, err := blobContents(blob)
+			if lfs != nil {
+				contents, err = location.lfsContents(lfs)
+				if errors.Is(err, fs.ErrNotExist) {
+					if err := builder.Add(zoekt.Document{
+						SkipReason:        fmt.Sprintf("Git LFS object %s is not available locally", lfs.OID),
+						Name:              keyFullPath,
+						Branches:          brs,
+						SubRepositoryPath: key.SubRepoPath,
+						Size:              size,
+					}); err != nil {
+						return err
+					}
+					continue
+				}
+			} else if contents == nil {
+				contents, err = objects.Blob(key.ID)
+			}
 			if err != nil {
 				return err
 			}
 
-			var pathRanks []float64
-			if len(ranks.Paths) > 0 {
-				// If the repository has ranking data, then store the file's rank.
-				pathRank := ranks.rank(keyFullPath)
-				pathRanks = []float64{pathRank}
-			}
-
-			if err := builder.Add(zoekt.Document{
+			doc := zoekt.Document{
 				SubRepositoryPath: key.SubRepoPath,
 				Name:              keyFullPath,
 				Content:           contents,
 				Branches:          brs,
-				Ranks:             pathRanks,
-			}); err != nil {
+				Author:            authors[key],
+			}
+			if key.SubRepoPath == "" {
+				attributes.apply(&doc)
+			}
+
+			if len(ranks.Paths) > 0 {
+				// If the repository has ranking data, then store the file's rank.
+				pathRank := ranks.rank(keyFullPath, build.IsLowPriorityDocument(&doc))
+				doc.Ranks = []float64{pathRank}
+			}
+
+			if err := builder.Add(doc); err != nil {
 				return fmt.Errorf("error adding document with name %s: %w", keyFullPath, err)
 			}
 		}
 	}
 
+	if opts.BuildOptions.IndexCommits > 0 {
+		docs, err := commitDocuments(repo, opts.BuildOptions.RepositoryDescription.Branches, opts.BuildOptions.IndexCommits)
+		if err != nil {
+			return fmt.Errorf("commitDocuments: %w", err)
+		}
+		for _, doc := range docs {
+			if err := builder.Add(doc); err != nil {
+				return fmt.Errorf("error adding commit %s: %w", doc.Name, err)
+			}
+		}
+	}
+
 	return builder.Finish()
 }
 
@@ -598,10 +708,10 @@ type repoPathRanks struct {
 //   - If we have a concrete rank for this file, always use it
 //   - If there's no rank, and it's a low priority file like a test, then use rank 0
 //   - Otherwise use the mean rank of this repository, to avoid giving it a big disadvantage
-func (r repoPathRanks) rank(path string) float64 {
+func (r repoPathRanks) rank(path string, lowPriority bool) float64 {
 	if rank, ok := r.Paths[path]; ok {
 		return rank
-	} else if build.IsLowPriority(path) {
+	} else if lowPriority {
 		return 0.0
 	} else {
 		return r.MeanRank
@@ -629,7 +739,7 @@ type prepareDeltaBuildFunc func(options Options, repository *git.Repository) (re
 
 // prepareNormalBuildFunc is a function that calculates the necessary metadata for preparing
 // a build.Builder instance for generating a normal build.
-type prepareNormalBuildFunc func(options Options, repository *git.Repository) (repos map[fileKey]BlobLocation, branchMap map[fileKey][]string, branchVersions map[string]map[string]plumbing.Hash, err error)
+type prepareNormalBuildFunc func(options Options, repository *git.Repository, reader ObjectReader) (repos map[fileKey]BlobLocation, branchMap map[fileKey][]string, branchVersions map[string]map[string]plumbing.Hash, err error)
 
 type gitIndexConfig struct {
 	// prepareDeltaBuild, if not nil, is the function that is used to calculate the metadata that will be used to
@@ -650,6 +760,10 @@ func prepareDeltaBuild(options Options, repository *git.Repository) (repos map[f
 		return nil, nil, nil, nil, fmt.Errorf("delta builds currently don't support submodule indexing")
 	}
 
+	if options.BuildOptions.IndexCommits > 0 {
+		return nil, nil, nil, nil, fmt.Errorf("delta builds currently don't support commit indexing")
+	}
+
 	// discover what commits we indexed during our last build
 	existingRepository, _, ok, err := options.BuildOptions.FindRepositoryMetadata()
 	if err != nil {
@@ -769,14 +883,16 @@ func prepareDeltaBuild(options Options, repository *git.Repository) (repos map[f
 				newFileRelativeRootPath := c.To.Name
 
 				// TODO@ggilmore: HACK - remove once ignore files are supported in delta builds
-				if newFileRelativeRootPath == ignore.IgnoreFile {
-					return nil, nil, nil, nil, fmt.Errorf("%q file is not yet supported in delta builds", ignore.IgnoreFile)
+				if newFileRelativeRootPath == ignore.IgnoreFile || newFileRelativeRootPath == RepoConfigFile || isGitattributes(newFileRelativeRootPath) {
+					return nil, nil, nil, nil, fmt.Errorf("%q file is not yet supported in delta builds", newFileRelativeRootPath)
 				}
 
 				// either file is added or renamed, so we need to add the new version to the build
-				file := fileKey{Path: newFileRelativeRootPath, ID: newFile.Hash}
-				repos[file] = hackSharedBlobLocation
-				branchMap[file] = append(branchMap[file], branch.Name)
+				if !options.BuildOptions.IsExcluded(newFileRelativeRootPath) {
+					file := fileKey{Path: newFileRelativeRootPath, ID: newFile.Hash}
+					repos[file] = hackSharedBlobLocation
+					branchMap[file] = append(branchMap[file], branch.Name)
+				}
 			}
 
 			if oldFile == nil {
@@ -788,26 +904,28 @@ func prepareDeltaBuild(options Options, repository *git.Repository) (repos map[f
 			// change's "Name" field is the only way that ggilmore saw to get the full path relative to the root
 			oldFileRelativeRootPath := c.From.Name
 
-			if oldFileRelativeRootPath == ignore.IgnoreFile {
-				return nil, nil, nil, nil, fmt.Errorf("%q file is not yet supported in delta builds", ignore.IgnoreFile)
+			if oldFileRelativeRootPath == ignore.IgnoreFile || oldFileRelativeRootPath == RepoConfigFile || isGitattributes(oldFileRelativeRootPath) {
+				return nil, nil, nil, nil, fmt.Errorf("%q file is not yet supported in delta builds", oldFileRelativeRootPath)
 			}
 
 			// The file is either modified or deleted. So, we need to add ALL versions
 			// of the old file (across all branches) to the build.
-			for b, currentTree := range branchToCurrentTree {
-				f, err := currentTree.File(oldFileRelativeRootPath)
-				if err != nil {
-					// the file doesn't exist in this branch
-					if errors.Is(err, object.ErrFileNotFound) {
-						continue
+			if !options.BuildOptions.IsExcluded(oldFileRelativeRootPath) {
+				for b, currentTree := range branchToCurrentTree {
+					f, err := currentTree.File(oldFileRelativeRootPath)
+					if err != nil {
+						// the file doesn't exist in this branch
+						if errors.Is(err, object.ErrFileNotFound) {
+							continue
+						}
+
+						return nil, nil, nil, nil, fmt.Errorf("getting hash for file %q in branch %q: %w", oldFile.Name, b, err)
 					}
 
-					return nil, nil, nil, nil, fmt.Errorf("getting hash for file %q in branch %q: %w", oldFile.Name, b, err)
+					file := fileKey{Path: oldFileRelativeRootPath, ID: f.ID()}
+					repos[file] = hackSharedBlobLocation
+					branchMap[file] = append(branchMap[file], b)
 				}
-
-				file := fileKey{Path: oldFileRelativeRootPath, ID: f.ID()}
-				repos[file] = hackSharedBlobLocation
-				branchMap[file] = append(branchMap[file], b)
 			}
 
 			changedOrDeletedPaths = append(changedOrDeletedPaths, oldFileRelativeRootPath)
@@ -831,7 +949,7 @@ func prepareDeltaBuild(options Options, repository *git.Repository) (repos map[f
 	return repos, branchMap, nil, changedOrDeletedPaths, nil
 }
 
-func prepareNormalBuild(options Options, repository *git.Repository) (repos map[fileKey]BlobLocation, branchMap map[fileKey][]string, branchVersions map[string]map[string]plumbing.Hash, err error) {
+func prepareNormalBuild(options Options, repository *git.Repository, reader ObjectReader) (repos map[fileKey]BlobLocation, branchMap map[fileKey][]string, branchVersions map[string]map[string]plumbing.Hash, err error) {
 	var repoCache *RepoCache
 	if options.Submodules {
 		repoCache = NewRepoCache(options.RepoCacheDir)
@@ -871,9 +989,19 @@ func prepareNormalBuild(options Options, repository *git.Repository) (repos map[
 			return nil, nil, nil, fmt.Errorf("newIgnoreMatcher: %w", err)
 		}
 
-		files, subVersions, err := TreeToFiles(repository, tree, options.BuildOptions.RepositoryDescription.URL, repoCache)
-		if err != nil {
-			return nil, nil, nil, fmt.Errorf("TreeToFiles: %w", err)
+		var files map[fileKey]BlobLocation
+		var subVersions map[string]plumbing.Hash
+		if options.ObjectBackend == CatFileBackend && !options.Submodules {
+			files, err = readerToFiles(reader, repository, commit.Hash, options.BuildOptions.RepositoryDescription.URL, options.BuildOptions.IsExcluded)
+			if err != nil {
+				return nil, nil, nil, fmt.Errorf("readerToFiles: %w", err)
+			}
+			subVersions = map[string]plumbing.Hash{}
+		} else {
+			files, subVersions, err = treeToFiles(repository, tree, options.BuildOptions.RepositoryDescription.URL, repoCache, options.BuildOptions.IsExcluded)
+			if err != nil {
+				return nil, nil, nil, fmt.Errorf("TreeToFiles: %w", err)
+			}
 		}
 		for k, v := range files {
 			if ig.Match(k.Path) {
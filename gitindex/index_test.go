@@ -91,6 +91,9 @@ func TestIndexDeltaBasic(t *testing.T) {
 	emptySourcegraphIgnore := zoekt.Document{Name: ignore.IgnoreFile}
 	sourcegraphIgnoreWithContent := zoekt.Document{Name: ignore.IgnoreFile, Content: []byte("good_content.txt")}
 
+	nestedAttributesV1 := zoekt.Document{Name: "sub/.gitattributes", Content: []byte("*.txt linguist-generated")}
+	nestedAttributesV2 := zoekt.Document{Name: "sub/.gitattributes", Content: []byte("*.txt linguist-vendored")}
+
 	for _, test := range []struct {
 		name     string
 		branches []string
@@ -467,6 +470,32 @@ func TestIndexDeltaBasic(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:     "should fallback to normal build if a .gitattributes file changes",
+			branches: []string{"main"},
+			steps: []step{
+				{
+					name: "setup",
+					addedDocuments: branchToDocumentMap{
+						"main": []zoekt.Document{nestedAttributesV1},
+					},
+
+					expectedDocuments: []zoekt.Document{nestedAttributesV1},
+				},
+				{
+					name: "attempt delta build after modifying a nested .gitattributes file",
+					addedDocuments: branchToDocumentMap{
+						"main": []zoekt.Document{nestedAttributesV2},
+					},
+					optFn: func(t *testing.T, o *Options) {
+						o.BuildOptions.IsDelta = true
+					},
+
+					expectedFallbackToNormalBuild: true,
+					expectedDocuments:             []zoekt.Document{nestedAttributesV2},
+				},
+			},
+		},
 		{
 			name:     "should fallback to a full, normal build if the repository has more than the specified threshold of shards",
 			branches: []string{"main"},
@@ -613,9 +642,9 @@ func TestIndexDeltaBasic(t *testing.T) {
 					}
 
 					normalBuildCalled := false
-					prepareNormalSpy := func(options Options, repository *git.Repository) (repos map[fileKey]BlobLocation, branchMap map[fileKey][]string, branchVersions map[string]map[string]plumbing.Hash, err error) {
+					prepareNormalSpy := func(options Options, repository *git.Repository, reader ObjectReader) (repos map[fileKey]BlobLocation, branchMap map[fileKey][]string, branchVersions map[string]map[string]plumbing.Hash, err error) {
 						normalBuildCalled = true
-						return prepareNormalBuild(options, repository)
+						return prepareNormalBuild(options, repository, reader)
 					}
 
 					// run test
@@ -744,7 +773,7 @@ func TestRepoPathRanks(t *testing.T) {
 
 	for _, tt := range cases {
 		t.Run(tt.name, func(t *testing.T) {
-			got := pathRanks.rank(tt.path)
+			got := pathRanks.rank(tt.path, build.IsLowPriority(tt.path))
 			if got != tt.rank {
 				t.Errorf("expected file '%s' to have rank %f, but got %f", tt.path, tt.rank, got)
 			}
@@ -0,0 +1,80 @@
+package gitindex
+
+import (
+	"bytes"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/go-git/go-git/v5/storage/filesystem"
+)
+
+// lfsPointerMaxSize is the maximum size of a Git LFS pointer file, see
+// https://github.com/git-lfs/git-lfs/blob/main/docs/spec.md.
+const lfsPointerMaxSize = 1024
+
+const lfsVersionLine = "version https://git-lfs.github.com/spec/v1\n"
+
+// lfsPointer is the content of a Git LFS pointer file, which stands in for
+// the actual content in the repository.
+type lfsPointer struct {
+	// OID is the SHA-256 of the content.
+	OID  string
+	Size int64
+}
+
+// parseLFSPointer returns the pointer if content is a Git LFS pointer file.
+func parseLFSPointer(content []byte) (*lfsPointer, bool) {
+	if len(content) > lfsPointerMaxSize || !bytes.HasPrefix(content, []byte(lfsVersionLine)) {
+		return nil, false
+	}
+
+	var p lfsPointer
+	size := false
+	for _, line := range strings.Split(string(content[len(lfsVersionLine):]), "\n") {
+		key, value, ok := strings.Cut(line, " ")
+		if !ok {
+			continue
+		}
+		switch key {
+		case "oid":
+			oid := strings.TrimPrefix(value, "sha256:")
+			if oid == value || len(oid) != 64 || strings.Trim(oid, "0123456789abcdef") != "" {
+				return nil, false
+			}
+			p.OID = oid
+		case "size":
+			n, err := strconv.ParseInt(value, 10, 64)
+			if err != nil || n < 0 {
+				return nil, false
+			}
+			p.Size = n
+			size = true
+		}
+	}
+	if p.OID == "" || !size {
+		return nil, false
+	}
+	return &p, true
+}
+
+// lfsContents reads the content of p from the local LFS object store of the
+// repository. The error wraps fs.ErrNotExist if the object was not fetched.
+func (l *BlobLocation) lfsContents(p *lfsPointer) ([]byte, error) {
+	storage, ok := l.Repo.Storer.(*filesystem.Storage)
+	if !ok {
+		return nil, fmt.Errorf("LFS object %s: %w", p.OID, fs.ErrNotExist)
+	}
+	path := filepath.Join(storage.Filesystem().Root(), "lfs", "objects", p.OID[0:2], p.OID[2:4], p.OID)
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(content)) != p.Size {
+		return nil, fmt.Errorf("LFS object %s: got %d bytes, want %d", p.OID, len(content), p.Size)
+	}
+	return content, nil
+}
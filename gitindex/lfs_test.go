@@ -0,0 +1,123 @@
+package gitindex
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/zoekt"
+	"github.com/sourcegraph/zoekt/build"
+	"github.com/sourcegraph/zoekt/query"
+	"github.com/sourcegraph/zoekt/shards"
+)
+
+func lfsPointerFor(content string) (string, string) {
+	sum := sha256.Sum256([]byte(content))
+	oid := hex.EncodeToString(sum[:])
+	return oid, fmt.Sprintf("%soid sha256:%s\nsize %d\n", lfsVersionLine, oid, len(content))
+}
+
+func TestParseLFSPointer(t *testing.T) {
+	oid, pointer := lfsPointerFor("hello")
+	p, ok := parseLFSPointer([]byte(pointer))
+	if !ok {
+		t.Fatal("pointer not recognized")
+	}
+	if d := cmp.Diff(&lfsPointer{OID: oid, Size: 5}, p); d != "" {
+		t.Errorf("mismatch (-want +got):\n%s", d)
+	}
+
+	for _, content := range []string{
+		"hello",
+		lfsVersionLine + "size 5\n",
+		lfsVersionLine + "oid sha256:abc\nsize 5\n",
+		lfsVersionLine + "oid sha256:" + oid + "\nsize -1\n",
+		pointer + strings.Repeat("x", lfsPointerMaxSize),
+	} {
+		if _, ok := parseLFSPointer([]byte(content)); ok {
+			t.Errorf("%q: recognized as pointer", content)
+		}
+	}
+}
+
+func TestIndexGitRepoLFS(t *testing.T) {
+	dir := t.TempDir()
+	present := "the real model weights\n"
+	presentOID, presentPointer := lfsPointerFor(present)
+	missingOID, missingPointer := lfsPointerFor("not fetched\n")
+	_, largePointer := lfsPointerFor(strings.Repeat("large\n", 100))
+
+	script := fmt.Sprintf(`git init -b master repo
+cd repo
+git config user.email "you@example.com"
+git config user.name "Your Name"
+printf '%%s' '%s' > present.bin
+printf '%%s' '%s' > missing.bin
+printf '%%s' '%s' > large.bin
+git add -A
+git commit -m "initial"
+`, presentPointer, missingPointer, largePointer)
+	cmd := exec.Command("/bin/sh", "-euxc", script)
+	cmd.Dir = dir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("execution error: %v, output %s", err, out)
+	}
+
+	repoDir := filepath.Join(dir, "repo")
+	objDir := filepath.Join(repoDir, ".git", "lfs", "objects", presentOID[0:2], presentOID[2:4])
+	if err := os.MkdirAll(objDir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(objDir, presentOID), []byte(present), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	indexDir := t.TempDir()
+	opts := Options{
+		RepoDir: repoDir,
+		BuildOptions: build.Options{
+			IndexDir: indexDir,
+			SizeMax:  500,
+			RepositoryDescription: zoekt.Repository{
+				Name: "repo",
+			},
+		},
+		BranchPrefix: "refs/heads",
+		Branches:     []string{"master"},
+	}
+	if err := IndexGitRepo(opts); err != nil {
+		t.Fatalf("IndexGitRepo: %v", err)
+	}
+
+	searcher, err := shards.NewDirectorySearcher(indexDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer searcher.Close()
+
+	res, err := searcher.Search(context.Background(), &query.Const{Value: true}, &zoekt.SearchOptions{Whole: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := map[string]string{}
+	for _, f := range res.Files {
+		got[f.FileName] = string(f.Content)
+	}
+	// Skipped files are stored with their skip reason as content.
+	want := map[string]string{
+		"present.bin": present,
+		"missing.bin": "NOT-INDEXED: Git LFS object " + missingOID + " is not available locally",
+		"large.bin":   "NOT-INDEXED: file size 600 exceeds maximum size 500",
+	}
+	if d := cmp.Diff(want, got); d != "" {
+		t.Errorf("mismatch (-want +got):\n%s", d)
+	}
+}
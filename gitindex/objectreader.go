@@ -0,0 +1,314 @@
+package gitindex
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"net/url"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+
+	git "github.com/go-git/go-git/v5"
+)
+
+// ObjectBackend selects the implementation of ObjectReader used to read the
+// files of a repository.
+type ObjectBackend string
+
+const (
+	// GoGitBackend decodes objects with go-git. It is the default.
+	GoGitBackend ObjectBackend = "go-git"
+
+	// CatFileBackend streams blobs through long-lived `git cat-file --batch`
+	// processes and lists trees with `git ls-tree -r`. It requires git in
+	// $PATH. Submodules are still read with go-git.
+	CatFileBackend ObjectBackend = "cat-file"
+)
+
+// ParseObjectBackend parses the name of an ObjectBackend. The empty string
+// selects GoGitBackend.
+func ParseObjectBackend(s string) (ObjectBackend, error) {
+	switch b := ObjectBackend(s); b {
+	case "", GoGitBackend:
+		return GoGitBackend, nil
+	case CatFileBackend:
+		return b, nil
+	}
+	return "", fmt.Errorf("unknown object backend %q, want %q or %q", s, GoGitBackend, CatFileBackend)
+}
+
+// ObjectReader reads the objects of a repository.
+type ObjectReader interface {
+	// Files returns the blob IDs of the files in the tree of commit, keyed
+	// by path. Submodules are left out.
+	Files(commit plumbing.Hash) (map[string]plumbing.Hash, error)
+
+	// Size returns the size of blob id.
+	Size(id plumbing.Hash) (int64, error)
+
+	// Blob returns the content of blob id.
+	Blob(id plumbing.Hash) ([]byte, error)
+
+	Close() error
+}
+
+// newObjectReader returns the ObjectReader for backend. repoDir is only used
+// by CatFileBackend.
+func newObjectReader(backend ObjectBackend, repo *git.Repository, repoDir string) ObjectReader {
+	if backend == CatFileBackend {
+		return &catFileReader{dir: repoDir}
+	}
+	return &goGitReader{repo: repo}
+}
+
+// readerToFiles is like treeToFiles for the tree of commit, but lists the
+// files with r. Submodules are not supported.
+func readerToFiles(r ObjectReader, repo *git.Repository, commit plumbing.Hash, repoURL string, exclude func(path string) bool) (map[fileKey]BlobLocation, error) {
+	files, err := r.Files(commit)
+	if err != nil {
+		return nil, err
+	}
+	u, _ := url.Parse(repoURL)
+	result := make(map[fileKey]BlobLocation, len(files))
+	for path, id := range files {
+		if exclude != nil && exclude(path) {
+			continue
+		}
+		result[fileKey{Path: path, ID: id}] = BlobLocation{Repo: repo, URL: u}
+	}
+	return result, nil
+}
+
+// goGitReader is the ObjectReader of GoGitBackend.
+type goGitReader struct {
+	repo *git.Repository
+}
+
+func (r *goGitReader) Files(commit plumbing.Hash) (map[string]plumbing.Hash, error) {
+	c, err := r.repo.CommitObject(commit)
+	if err != nil {
+		return nil, err
+	}
+	tree, err := c.Tree()
+	if err != nil {
+		return nil, err
+	}
+	files, _, err := TreeToFiles(r.repo, tree, "", nil)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string]plumbing.Hash, len(files))
+	for k := range files {
+		result[k.Path] = k.ID
+	}
+	return result, nil
+}
+
+func (r *goGitReader) Size(id plumbing.Hash) (int64, error) {
+	return r.repo.Storer.EncodedObjectSize(id)
+}
+
+func (r *goGitReader) Blob(id plumbing.Hash) ([]byte, error) {
+	blob, err := r.repo.BlobObject(id)
+	if err != nil {
+		return nil, err
+	}
+	return blobContents(blob)
+}
+
+func (r *goGitReader) Close() error {
+	return nil
+}
+
+// catFileReader is the ObjectReader of CatFileBackend. The cat-file
+// processes are started on first use.
+type catFileReader struct {
+	dir string
+
+	// batch answers with the header and content of objects, check only with
+	// the header.
+	batch *catFileProcess
+	check *catFileProcess
+}
+
+func (r *catFileReader) Files(commit plumbing.Hash) (map[string]plumbing.Hash, error) {
+	cmd := exec.Command("git", "ls-tree", "-r", "-z", "--full-tree", commit.String())
+	cmd.Dir = r.dir
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("git ls-tree %s: %w: %s", commit, err, stderr.String())
+	}
+	return parseLsTree(out)
+}
+
+// parseLsTree parses the output of `git ls-tree -r -z`, which has an entry
+// "<mode> SP <type> SP <object> TAB <path>" per file.
+func parseLsTree(out []byte) (map[string]plumbing.Hash, error) {
+	files := map[string]plumbing.Hash{}
+	for _, entry := range bytes.Split(out, []byte{0}) {
+		if len(entry) == 0 {
+			continue
+		}
+		meta, path, ok := strings.Cut(string(entry), "\t")
+		if !ok {
+			return nil, fmt.Errorf("malformed ls-tree entry %q", entry)
+		}
+		fields := strings.Fields(meta)
+		if len(fields) != 3 {
+			return nil, fmt.Errorf("malformed ls-tree entry %q", entry)
+		}
+		mode, err := filemode.New(fields[0])
+		if err != nil {
+			return nil, err
+		}
+		switch mode {
+		case filemode.Regular, filemode.Executable, filemode.Symlink:
+		default:
+			continue
+		}
+		files[path] = plumbing.NewHash(fields[2])
+	}
+	return files, nil
+}
+
+func (r *catFileReader) Size(id plumbing.Hash) (int64, error) {
+	if r.check == nil {
+		p, err := startCatFile(r.dir, "--batch-check")
+		if err != nil {
+			return 0, err
+		}
+		r.check = p
+	}
+	return r.check.header(id)
+}
+
+func (r *catFileReader) Blob(id plumbing.Hash) ([]byte, error) {
+	if r.batch == nil {
+		p, err := startCatFile(r.dir, "--batch")
+		if err != nil {
+			return nil, err
+		}
+		r.batch = p
+	}
+	size, err := r.batch.header(id)
+	if err != nil {
+		return nil, err
+	}
+	// The content is followed by a newline.
+	content := make([]byte, size+1)
+	if _, err := io.ReadFull(r.batch.out, content); err != nil {
+		return nil, r.batch.fail(err)
+	}
+	return content[:size], nil
+}
+
+func (r *catFileReader) Close() error {
+	var err error
+	for _, p := range []*catFileProcess{r.batch, r.check} {
+		if p == nil {
+			continue
+		}
+		if closeErr := p.close(); err == nil {
+			err = closeErr
+		}
+	}
+	r.batch, r.check = nil, nil
+	return err
+}
+
+// catFileProcess is a running `git cat-file` process in batch mode.
+type catFileProcess struct {
+	cmd *exec.Cmd
+	in  io.WriteCloser
+	out *bufio.Reader
+
+	// contents is true if replies have the content of the object after the
+	// header, ie. for --batch.
+	contents bool
+
+	// err is set once the process is unusable, eg. because the output was
+	// not read completely.
+	err error
+}
+
+func startCatFile(dir, mode string) (*catFileProcess, error) {
+	cmd := exec.Command("git", "cat-file", mode)
+	cmd.Dir = dir
+	in, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+	out, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("git cat-file %s: %w", mode, err)
+	}
+	return &catFileProcess{
+		cmd:      cmd,
+		in:       in,
+		out:      bufio.NewReaderSize(out, 64*1024),
+		contents: mode == "--batch",
+	}, nil
+}
+
+// header requests blob id and reads the header "<oid> SP <type> SP <size>"
+// of the reply, returning the size.
+func (p *catFileProcess) header(id plumbing.Hash) (int64, error) {
+	if p.err != nil {
+		return 0, p.err
+	}
+	if _, err := fmt.Fprintf(p.in, "%s\n", id); err != nil {
+		return 0, p.fail(err)
+	}
+	line, err := p.out.ReadString('\n')
+	if err != nil {
+		return 0, p.fail(err)
+	}
+	fields := strings.Fields(line)
+	if len(fields) == 2 && fields[1] == "missing" {
+		return 0, plumbing.ErrObjectNotFound
+	}
+	if len(fields) != 3 {
+		return 0, p.fail(fmt.Errorf("malformed cat-file header %q", line))
+	}
+	size, err := strconv.ParseInt(fields[2], 10, 64)
+	if err != nil {
+		return 0, p.fail(err)
+	}
+	if fields[1] != "blob" {
+		if p.contents {
+			if _, err := p.out.Discard(int(size) + 1); err != nil {
+				return 0, p.fail(err)
+			}
+		}
+		return 0, fmt.Errorf("object %s is a %s, not a blob", id, fields[1])
+	}
+	return size, nil
+}
+
+// fail marks the process as unusable, since its output is out of sync with
+// the requests.
+func (p *catFileProcess) fail(err error) error {
+	p.err = fmt.Errorf("git cat-file: %w", err)
+	return p.err
+}
+
+func (p *catFileProcess) close() error {
+	if p.err != nil {
+		// Unread output could block the process.
+		_ = p.cmd.Process.Kill()
+		_ = p.cmd.Wait()
+		return nil
+	}
+	p.in.Close()
+	return p.cmd.Wait()
+}
@@ -0,0 +1,204 @@
+package gitindex
+
+import (
+	"context"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/go-git/go-git/v5/plumbing"
+
+	git "github.com/go-git/go-git/v5"
+
+	"github.com/sourcegraph/zoekt"
+	"github.com/sourcegraph/zoekt/build"
+	"github.com/sourcegraph/zoekt/query"
+	"github.com/sourcegraph/zoekt/shards"
+)
+
+func createObjectsRepo(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	script := `git init -b master repo
+cd repo
+git config user.email "you@example.com"
+git config user.name "Your Name"
+mkdir -p dir/nested
+echo 'package main' > main.go
+printf '#!/bin/sh\necho hi\n' > run.sh
+chmod +x run.sh
+ln -s main.go link.go
+: > empty.txt
+printf 'bin\000ary\377' > data.bin
+printf 'tab' > "$(printf 'with\ttab.txt')"
+echo 'ünïcode' > dir/nested/ünïcode.txt
+seq 1 100000 > dir/large.txt
+git add -A
+git update-index --add --cacheinfo 160000,1111111111111111111111111111111111111111,submodule
+git commit -m "initial"
+`
+	cmd := exec.Command("/bin/sh", "-euxc", script)
+	cmd.Dir = dir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("execution error: %v, output %s", err, out)
+	}
+	return filepath.Join(dir, "repo")
+}
+
+func TestObjectReaderEquivalence(t *testing.T) {
+	repoDir := createObjectsRepo(t)
+	repo, err := git.PlainOpen(repoDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	head, err := repo.Head()
+	if err != nil {
+		t.Fatal(err)
+	}
+	commit, err := repo.CommitObject(head.Hash())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	goGit := newObjectReader(GoGitBackend, repo, repoDir)
+	defer goGit.Close()
+	catFile := newObjectReader(CatFileBackend, repo, repoDir)
+	defer catFile.Close()
+
+	want, err := goGit.Files(head.Hash())
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := catFile.Files(head.Hash())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d := cmp.Diff(want, got); d != "" {
+		t.Fatalf("Files mismatch (-go-git +cat-file):\n%s", d)
+	}
+	if len(got) != 8 {
+		t.Errorf("got %d files, want 8", len(got))
+	}
+
+	var paths []string
+	for p := range want {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+
+	// Interleave the requests, to check that the cat-file processes stay in
+	// sync.
+	for _, p := range paths {
+		id := want[p]
+		wantSize, err := goGit.Size(id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		gotSize, err := catFile.Size(id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if gotSize != wantSize {
+			t.Errorf("%s: got size %d, want %d", p, gotSize, wantSize)
+		}
+
+		wantBlob, err := goGit.Blob(id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		gotBlob, err := catFile.Blob(id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(gotBlob) != string(wantBlob) {
+			t.Errorf("%s: got content %q, want %q", p, gotBlob, wantBlob)
+		}
+	}
+
+	// Errors leave the processes usable.
+	missing := plumbing.NewHash("2222222222222222222222222222222222222222")
+	for _, id := range []plumbing.Hash{missing, commit.TreeHash} {
+		if _, err := goGit.Blob(id); err == nil {
+			t.Errorf("go-git: no error for %s", id)
+		}
+		if _, err := catFile.Blob(id); err == nil {
+			t.Errorf("cat-file: no error for %s", id)
+		}
+		if _, err := catFile.Size(id); err == nil && id == missing {
+			t.Errorf("cat-file: no error for size of %s", id)
+		}
+	}
+	if _, err := catFile.Blob(want["main.go"]); err != nil {
+		t.Errorf("cat-file after errors: %v", err)
+	}
+	if err := catFile.Close(); err != nil {
+		t.Errorf("Close: %v", err)
+	}
+}
+
+func TestIndexGitRepoObjectBackends(t *testing.T) {
+	repoDir := createObjectsRepo(t)
+
+	search := func(backend ObjectBackend) map[string]string {
+		indexDir := t.TempDir()
+		opts := Options{
+			RepoDir: repoDir,
+			BuildOptions: build.Options{
+				IndexDir: indexDir,
+				RepositoryDescription: zoekt.Repository{
+					Name: "repo",
+				},
+			},
+			BranchPrefix:  "refs/heads",
+			Branches:      []string{"master"},
+			ObjectBackend: backend,
+		}
+		if err := IndexGitRepo(opts); err != nil {
+			t.Fatalf("IndexGitRepo(%s): %v", backend, err)
+		}
+
+		searcher, err := shards.NewDirectorySearcher(indexDir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer searcher.Close()
+
+		res, err := searcher.Search(context.Background(), &query.Const{Value: true}, &zoekt.SearchOptions{Whole: true})
+		if err != nil {
+			t.Fatal(err)
+		}
+		files := map[string]string{}
+		for _, f := range res.Files {
+			files[f.FileName] = string(f.Content)
+		}
+		return files
+	}
+
+	want := search(GoGitBackend)
+	got := search(CatFileBackend)
+	if d := cmp.Diff(want, got); d != "" {
+		t.Errorf("mismatch (-go-git +cat-file):\n%s", d)
+	}
+	if len(got) == 0 {
+		t.Error("no files indexed")
+	}
+}
+
+func TestParseObjectBackend(t *testing.T) {
+	for in, want := range map[string]ObjectBackend{
+		"":         GoGitBackend,
+		"go-git":   GoGitBackend,
+		"cat-file": CatFileBackend,
+	} {
+		got, err := ParseObjectBackend(in)
+		if err != nil || got != want {
+			t.Errorf("ParseObjectBackend(%q) = %q, %v, want %q", in, got, err, want)
+		}
+	}
+	if _, err := ParseObjectBackend("libgit2"); err == nil {
+		t.Error("expected error for unknown backend")
+	}
+}
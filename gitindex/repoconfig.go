@@ -0,0 +1,129 @@
+package gitindex
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/go-git/go-git/v5/plumbing/object"
+
+	git "github.com/go-git/go-git/v5"
+)
+
+// RepoConfigFile is the path of the optional per-repository configuration
+// in the indexed tree. It lets repository owners control how their
+// repository is indexed, eg.
+//
+//	{
+//	  "large_files": ["data/*.json"],
+//	  "exclude_files": ["third_party/**"],
+//	  "languages": {"**/*.h": "C++"},
+//	  "branches": ["release"]
+//	}
+const RepoConfigFile = ".sourcegraph/zoekt.json"
+
+// repoConfig is the format of RepoConfigFile.
+type repoConfig struct {
+	// LargeFiles are added to build.Options.LargeFiles.
+	LargeFiles []string `json:"large_files"`
+
+	// ExcludeFiles are added to build.Options.ExcludeFiles.
+	ExcludeFiles []string `json:"exclude_files"`
+
+	// Languages maps glob patterns to languages, see
+	// build.Options.LanguageOverrides.
+	Languages map[string]string `json:"languages"`
+
+	// Branches are indexed in addition to Options.Branches.
+	Branches []string `json:"branches"`
+}
+
+// loadRepoConfig reads RepoConfigFile from the first branch in opts, which
+// is usually HEAD. It returns an empty config if the branch does not exist.
+func loadRepoConfig(repo *git.Repository, opts Options) (*repoConfig, error) {
+	if len(opts.Branches) == 0 {
+		return &repoConfig{}, nil
+	}
+	commit, err := getCommit(repo, opts.BranchPrefix, opts.Branches[0])
+	if err != nil {
+		// Missing branches are reported while indexing.
+		return &repoConfig{}, nil
+	}
+	tree, err := commit.Tree()
+	if err != nil {
+		return nil, fmt.Errorf("commit.Tree: %w", err)
+	}
+	return readRepoConfig(tree)
+}
+
+// readRepoConfig reads RepoConfigFile from tree. It returns an empty config
+// if the file does not exist or is invalid, so a broken config can't stop
+// the repository from being indexed.
+func readRepoConfig(tree *object.Tree) (*repoConfig, error) {
+	f, err := tree.File(RepoConfigFile)
+	if err == object.ErrFileNotFound {
+		return &repoConfig{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	content, err := f.Contents()
+	if err != nil {
+		return nil, err
+	}
+
+	var cfg repoConfig
+	if err := json.Unmarshal([]byte(content), &cfg); err != nil {
+		log.Printf("ignoring invalid %s: %v", RepoConfigFile, err)
+		return &repoConfig{}, nil
+	}
+	return &cfg, nil
+}
+
+// apply merges cfg into opts. The options set by the indexserver take
+// precedence: later LargeFiles patterns override earlier ones, so the
+// patterns of the repository are added first. Branches which don't exist in
+// repo are ignored.
+func (cfg *repoConfig) apply(repo *git.Repository, opts *Options) {
+	bo := &opts.BuildOptions
+	if len(cfg.LargeFiles) > 0 {
+		bo.LargeFiles = append(append([]string{}, cfg.LargeFiles...), bo.LargeFiles...)
+	}
+
+	bo.ExcludeFiles = append(bo.ExcludeFiles[:len(bo.ExcludeFiles):len(bo.ExcludeFiles)], cfg.ExcludeFiles...)
+
+	// Sort for a stable hash of the options.
+	patterns := make([]string, 0, len(cfg.Languages))
+	for pattern := range cfg.Languages {
+		patterns = append(patterns, pattern)
+	}
+	sort.Strings(patterns)
+	overrides := make([]string, 0, len(patterns)+len(bo.LanguageOverrides))
+	for _, pattern := range patterns {
+		overrides = append(overrides, pattern+"="+cfg.Languages[pattern])
+	}
+	if len(overrides) > 0 {
+		bo.LanguageOverrides = append(overrides, bo.LanguageOverrides...)
+	}
+
+	for _, b := range cfg.Branches {
+		if contains(opts.Branches, b) {
+			continue
+		}
+		if _, err := getCommit(repo, opts.BranchPrefix, b); err != nil {
+			log.Printf("ignoring branch %q of %s: %v", b, RepoConfigFile, err)
+			continue
+		}
+		opts.Branches = append(opts.Branches[:len(opts.Branches):len(opts.Branches)], b)
+	}
+}
+
+func contains(s []string, v string) bool {
+	for _, x := range s {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,160 @@
+package gitindex
+
+import (
+	"context"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/zoekt"
+	"github.com/sourcegraph/zoekt/build"
+	"github.com/sourcegraph/zoekt/query"
+	"github.com/sourcegraph/zoekt/shards"
+)
+
+func TestRepoConfig(t *testing.T) {
+	dir := t.TempDir()
+
+	script := `mkdir repo
+cd repo
+git init -b master
+git config user.email "you@example.com"
+git config user.name "Your Name"
+mkdir -p .sourcegraph vendor
+echo 'func main() {}' > main.tpl
+echo 'vendored' > vendor/lib.go
+printf 'large%.0s' $(seq 1 100) > big.txt
+cat > .sourcegraph/zoekt.json <<EOF
+{
+  "large_files": ["big.txt"],
+  "exclude_files": ["vendor/**"],
+  "languages": {"*.tpl": "golang"},
+  "branches": ["release", "missing"]
+}
+EOF
+git add -A
+git commit -m "initial"
+git checkout -b release
+echo 'released' > release.txt
+git add release.txt
+git commit -m "release"
+git checkout master
+`
+	cmd := exec.Command("/bin/sh", "-euxc", script)
+	cmd.Dir = dir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("execution error: %v, output %s", err, out)
+	}
+
+	indexDir := t.TempDir()
+	opts := Options{
+		RepoDir: filepath.Join(dir, "repo"),
+		BuildOptions: build.Options{
+			IndexDir: indexDir,
+			SizeMax:  200,
+			RepositoryDescription: zoekt.Repository{
+				Name: "repo",
+			},
+		},
+		BranchPrefix: "refs/heads",
+		Branches:     []string{"master"},
+	}
+	if err := IndexGitRepo(opts); err != nil {
+		t.Fatalf("IndexGitRepo: %v", err)
+	}
+
+	searcher, err := shards.NewDirectorySearcher(indexDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer searcher.Close()
+
+	res, err := searcher.Search(context.Background(), &query.Const{Value: true}, &zoekt.SearchOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	languages := map[string]string{}
+	for _, f := range res.Files {
+		languages[f.FileName] = f.Language
+	}
+	want := map[string]string{
+		".sourcegraph/zoekt.json": "JSON",
+		"big.txt":                 "Text",
+		"main.tpl":                "Go",
+		"release.txt":             "Text",
+	}
+	if d := cmp.Diff(want, languages); d != "" {
+		t.Errorf("files mismatch (-want +got):\n%s", d)
+	}
+
+	repos, err := searcher.List(context.Background(), &query.Const{Value: true}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(repos.Repos) != 1 {
+		t.Fatalf("got %d repos, want 1", len(repos.Repos))
+	}
+	var branches []string
+	for _, b := range repos.Repos[0].Repository.Branches {
+		branches = append(branches, b.Name)
+	}
+	sort.Strings(branches)
+	if d := cmp.Diff([]string{"master", "release"}, branches); d != "" {
+		t.Errorf("branches mismatch (-want +got):\n%s", d)
+	}
+}
+
+func TestRepoConfigInvalid(t *testing.T) {
+	dir := t.TempDir()
+
+	script := `mkdir repo
+cd repo
+git init -b master
+git config user.email "you@example.com"
+git config user.name "Your Name"
+mkdir .sourcegraph
+echo '{"branches": [' > .sourcegraph/zoekt.json
+echo 'needle' > file.txt
+git add -A
+git commit -m "initial"
+`
+	cmd := exec.Command("/bin/sh", "-euxc", script)
+	cmd.Dir = dir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("execution error: %v, output %s", err, out)
+	}
+
+	indexDir := t.TempDir()
+	opts := Options{
+		RepoDir: filepath.Join(dir, "repo"),
+		BuildOptions: build.Options{
+			IndexDir: indexDir,
+			RepositoryDescription: zoekt.Repository{
+				Name: "repo",
+			},
+		},
+		BranchPrefix: "refs/heads",
+		Branches:     []string{"master"},
+	}
+	if err := IndexGitRepo(opts); err != nil {
+		t.Fatalf("IndexGitRepo: %v", err)
+	}
+
+	searcher, err := shards.NewDirectorySearcher(indexDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer searcher.Close()
+
+	res, err := searcher.Search(context.Background(), &query.Substring{Pattern: "needle"}, &zoekt.SearchOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Files) != 1 {
+		t.Errorf("got %d files, want 1", len(res.Files))
+	}
+}
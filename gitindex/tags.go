@@ -0,0 +1,146 @@
+package gitindex
+
+import (
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing"
+
+	git "github.com/go-git/go-git/v5"
+)
+
+// expandTags returns the names of the tags matching any of the glob
+// patterns, highest version first. If max is positive, only the max highest
+// versions are returned.
+func expandTags(repo *git.Repository, patterns []string, max int) ([]string, error) {
+	iter, err := repo.Tags()
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	var result []string
+	err = iter.ForEach(func(ref *plumbing.Reference) error {
+		name := ref.Name().Short()
+		for _, p := range patterns {
+			if matched, err := filepath.Match(p, name); err != nil {
+				return err
+			} else if matched {
+				result = append(result, name)
+				return nil
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return compareTags(result[i], result[j]) > 0
+	})
+	if max > 0 && len(result) > max {
+		result = result[:max]
+	}
+	return result, nil
+}
+
+// version is a parsed semantic version, see https://semver.org.
+type version struct {
+	core       []int
+	prerelease []string
+}
+
+// parseVersion parses tags like "v1.2.3" or "1.2.3-rc.1+build". Versions
+// may have fewer or more than three components. It returns false if tag is
+// not a version.
+func parseVersion(tag string) (version, bool) {
+	s := strings.TrimPrefix(strings.TrimPrefix(tag, "v"), "V")
+	if i := strings.IndexByte(s, '+'); i >= 0 {
+		s = s[:i]
+	}
+
+	var v version
+	if i := strings.IndexByte(s, '-'); i >= 0 {
+		if i == len(s)-1 {
+			return version{}, false
+		}
+		v.prerelease = strings.Split(s[i+1:], ".")
+		s = s[:i]
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return version{}, false
+		}
+		v.core = append(v.core, n)
+	}
+	return v, true
+}
+
+// compareTags orders versions by semantic versioning precedence, and sorts
+// them before tags which are not versions. It returns a negative number if
+// a < b, a positive number if a > b and 0 if they are equal.
+func compareTags(a, b string) int {
+	va, okA := parseVersion(a)
+	vb, okB := parseVersion(b)
+	switch {
+	case okA && !okB:
+		return 1
+	case !okA && okB:
+		return -1
+	case !okA && !okB:
+		return strings.Compare(a, b)
+	}
+
+	for i := 0; i < len(va.core) || i < len(vb.core); i++ {
+		var x, y int
+		if i < len(va.core) {
+			x = va.core[i]
+		}
+		if i < len(vb.core) {
+			y = vb.core[i]
+		}
+		if x != y {
+			return x - y
+		}
+	}
+
+	// A version without prerelease is higher than one with.
+	switch {
+	case va.prerelease == nil && vb.prerelease != nil:
+		return 1
+	case va.prerelease != nil && vb.prerelease == nil:
+		return -1
+	}
+	for i := 0; i < len(va.prerelease) && i < len(vb.prerelease); i++ {
+		if c := comparePrerelease(va.prerelease[i], vb.prerelease[i]); c != 0 {
+			return c
+		}
+	}
+	if c := len(va.prerelease) - len(vb.prerelease); c != 0 {
+		return c
+	}
+
+	// Equal versions, eg. "v1.0" and "1.0.0".
+	return strings.Compare(a, b)
+}
+
+// comparePrerelease compares prerelease identifiers. Numeric identifiers
+// are compared numerically and are lower than alphanumeric ones.
+func comparePrerelease(a, b string) int {
+	x, errA := strconv.Atoi(a)
+	y, errB := strconv.Atoi(b)
+	switch {
+	case errA == nil && errB == nil:
+		return x - y
+	case errA == nil:
+		return -1
+	case errB == nil:
+		return 1
+	}
+	return strings.Compare(a, b)
+}
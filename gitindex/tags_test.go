@@ -0,0 +1,145 @@
+package gitindex
+
+import (
+	"context"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	git "github.com/go-git/go-git/v5"
+
+	"github.com/sourcegraph/zoekt"
+	"github.com/sourcegraph/zoekt/build"
+	"github.com/sourcegraph/zoekt/query"
+	"github.com/sourcegraph/zoekt/shards"
+)
+
+func TestCompareTags(t *testing.T) {
+	tags := []string{"v1.2.0", "release", "v1.10.0", "v1.9.0-rc.1", "v1.9.0", "v1.9.0-rc.10", "v1.9.0-beta", "2.0", "v1.9"}
+	sort.Slice(tags, func(i, j int) bool {
+		return compareTags(tags[i], tags[j]) > 0
+	})
+	want := []string{"2.0", "v1.10.0", "v1.9.0", "v1.9", "v1.9.0-rc.10", "v1.9.0-rc.1", "v1.9.0-beta", "v1.2.0", "release"}
+	if d := cmp.Diff(want, tags); d != "" {
+		t.Errorf("mismatch (-want +got):\n%s", d)
+	}
+}
+
+func createTagsRepo(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	script := `git init -b master repo
+cd repo
+git config user.email "you@example.com"
+git config user.name "Your Name"
+echo 'one' > version.txt
+git add -A
+git commit -m "one"
+git tag v1.2.0
+echo 'nine' > version.txt
+git commit -am "nine"
+git tag -a v1.9.0 -m "annotated"
+git tag latest
+echo 'ten' > version.txt
+git commit -am "ten"
+git tag v1.10.0
+git tag v1.10.0-rc.1
+echo 'head' > version.txt
+git commit -am "head"
+`
+	cmd := exec.Command("/bin/sh", "-euxc", script)
+	cmd.Dir = dir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("execution error: %v, output %s", err, out)
+	}
+	return filepath.Join(dir, "repo")
+}
+
+func TestExpandTags(t *testing.T) {
+	repo, err := git.PlainOpen(createTagsRepo(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		patterns []string
+		max      int
+		want     []string
+	}{
+		{[]string{"v*"}, 0, []string{"v1.10.0", "v1.10.0-rc.1", "v1.9.0", "v1.2.0"}},
+		{[]string{"v*"}, 2, []string{"v1.10.0", "v1.10.0-rc.1"}},
+		{[]string{"v1.9*", "latest"}, 0, []string{"v1.9.0", "latest"}},
+		{[]string{"x*"}, 0, nil},
+	} {
+		got, err := expandTags(repo, tc.patterns, tc.max)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if d := cmp.Diff(tc.want, got); d != "" {
+			t.Errorf("%v max %d: mismatch (-want +got):\n%s", tc.patterns, tc.max, d)
+		}
+	}
+
+	if _, err := expandTags(repo, []string{"["}, 0); err == nil {
+		t.Error("expected error for malformed pattern")
+	}
+}
+
+func TestIndexGitRepoTags(t *testing.T) {
+	indexDir := t.TempDir()
+	opts := Options{
+		RepoDir: createTagsRepo(t),
+		BuildOptions: build.Options{
+			IndexDir: indexDir,
+			RepositoryDescription: zoekt.Repository{
+				Name: "repo",
+			},
+		},
+		BranchPrefix: "refs/heads",
+		Branches:     []string{"master"},
+		Tags:         []string{"v*.*.*", "latest"},
+		MaxTags:      3,
+	}
+	if err := IndexGitRepo(opts); err != nil {
+		t.Fatalf("IndexGitRepo: %v", err)
+	}
+
+	searcher, err := shards.NewDirectorySearcher(indexDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer searcher.Close()
+
+	repos, err := searcher.List(context.Background(), &query.Const{Value: true}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(repos.Repos) != 1 {
+		t.Fatalf("got %d repos, want 1", len(repos.Repos))
+	}
+	var branches []string
+	for _, b := range repos.Repos[0].Repository.Branches {
+		branches = append(branches, b.Name)
+	}
+	if d := cmp.Diff([]string{"master", "v1.10.0", "v1.10.0-rc.1", "v1.9.0"}, branches); d != "" {
+		t.Errorf("branches mismatch (-want +got):\n%s", d)
+	}
+
+	for branch, want := range map[string]string{
+		"master":  "head",
+		"v1.10.0": "ten",
+		"v1.9.0":  "nine",
+	} {
+		q := query.NewAnd(&query.Branch{Pattern: branch, Exact: true}, &query.Substring{Pattern: want, Content: true})
+		res, err := searcher.Search(context.Background(), q, &zoekt.SearchOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(res.Files) != 1 {
+			t.Errorf("branch:%s %s: got %d files, want 1", branch, want, len(res.Files))
+		}
+	}
+}
@@ -15,8 +15,10 @@
 package gitindex
 
 import (
+	"errors"
 	"fmt"
 	"io"
+	"io/fs"
 	"log"
 	"net/url"
 	"path"
@@ -43,6 +45,9 @@ type repoWalker struct {
 	// Path => commit SHA1
 	subRepoVersions map[string]plumbing.Hash
 	repoCache       *RepoCache
+
+	// exclude, if set, returns true for paths which should not be indexed.
+	exclude func(path string) bool
 }
 
 // subURL returns the URL for a submodule.
@@ -99,7 +104,16 @@ func (rw *repoWalker) parseModuleMap(t *object.Tree) error {
 // that indicates in which repo each SHA1 can be found.
 func TreeToFiles(r *git.Repository, t *object.Tree,
 	repoURL string, repoCache *RepoCache) (map[fileKey]BlobLocation, map[string]plumbing.Hash, error) {
+	return treeToFiles(r, t, repoURL, repoCache, nil)
+}
+
+// treeToFiles is like TreeToFiles, but leaves out files for which exclude
+// returns true. exclude is called with the path relative to the root of
+// the tree, and may be nil.
+func treeToFiles(r *git.Repository, t *object.Tree,
+	repoURL string, repoCache *RepoCache, exclude func(path string) bool) (map[fileKey]BlobLocation, map[string]plumbing.Hash, error) {
 	rw := newRepoWalker(r, repoURL, repoCache)
+	rw.exclude = exclude
 
 	if err := rw.parseModuleMap(t); err != nil {
 		return nil, nil, fmt.Errorf("parseModuleMap: %w", err)
@@ -126,18 +140,28 @@ func (r *repoWalker) tryHandleSubmodule(p string, id *plumbing.Hash) error {
 	return nil
 }
 
-func (r *repoWalker) handleSubmodule(p string, id *plumbing.Hash) error {
+// openSubmodule opens the repository of the submodule at path p from the
+// repo cache.
+func (r *repoWalker) openSubmodule(p string) (*git.Repository, *url.URL, error) {
 	submod := r.submodules[p]
 	if submod == nil {
-		return fmt.Errorf("no entry for submodule path %q", r.repoURL)
+		return nil, nil, fmt.Errorf("no entry for submodule path %q", r.repoURL)
 	}
 
 	subURL, err := r.subURL(submod.URL)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
 	subRepo, err := r.repoCache.Open(subURL)
+	if err != nil {
+		return nil, nil, err
+	}
+	return subRepo, subURL, nil
+}
+
+func (r *repoWalker) handleSubmodule(p string, id *plumbing.Hash) error {
+	subRepo, subURL, err := r.openSubmodule(p)
 	if err != nil {
 		return err
 	}
@@ -153,7 +177,14 @@ func (r *repoWalker) handleSubmodule(p string, id *plumbing.Hash) error {
 
 	r.subRepoVersions[p] = *id
 
-	subTree, subVersions, err := TreeToFiles(subRepo, tree, subURL.String(), r.repoCache)
+	var exclude func(string) bool
+	if r.exclude != nil {
+		exclude = func(name string) bool {
+			return r.exclude(filepath.Join(p, name))
+		}
+	}
+
+	subTree, subVersions, err := treeToFiles(subRepo, tree, subURL.String(), r.repoCache, exclude)
 	if err != nil {
 		return err
 	}
@@ -183,6 +214,10 @@ func (r *repoWalker) handleEntry(p string, e *object.TreeEntry) error {
 		return nil
 	}
 
+	if r.exclude != nil && r.exclude(p) {
+		return nil
+	}
+
 	r.tree[fileKey{
 		Path: p,
 		ID:   e.Hash,
@@ -211,10 +246,23 @@ type BlobLocation struct {
 	URL  *url.URL
 }
 
+// Blob returns the contents of the blob. Git LFS pointers are resolved from
+// the local LFS object store, if the object is present there.
 func (l *BlobLocation) Blob(id *plumbing.Hash) ([]byte, error) {
 	blob, err := l.Repo.BlobObject(*id)
 	if err != nil {
 		return nil, err
 	}
-	return blobContents(blob)
+	content, err := blobContents(blob)
+	if err != nil {
+		return nil, err
+	}
+	if p, ok := parseLFSPointer(content); ok {
+		if lfs, err := l.lfsContents(p); err == nil {
+			return lfs, nil
+		} else if !errors.Is(err, fs.ErrNotExist) {
+			return nil, err
+		}
+	}
+	return content, nil
 }
@@ -192,6 +192,62 @@ func TestTreeToFiles(t *testing.T) {
 	}
 }
 
+func TestTreeToFilesExclude(t *testing.T) {
+	dir := t.TempDir()
+
+	if err := createSubmoduleRepo(dir); err != nil {
+		t.Fatalf("createSubmoduleRepo: %v", err)
+	}
+
+	cache := NewRepoCache(dir)
+
+	aURL, _ := url.Parse("http://gerrit.googlesource.com/adir")
+	repo, err := cache.Open(aURL)
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	headRef, err := repo.Head()
+	if err != nil {
+		t.Fatalf("HEAD tree: %v", err)
+	}
+	commit, err := repo.CommitObject(headRef.Hash())
+	if err != nil {
+		t.Fatalf("commit obj HEAD: %v", err)
+	}
+	tree, err := repo.TreeObject(commit.TreeHash)
+	if err != nil {
+		t.Fatalf("AsTree: %v", err)
+	}
+
+	opts := build.Options{
+		IncludeFiles: []string{"afile", "bname/**"},
+		ExcludeFiles: []string{"bname/bsymlink"},
+	}
+	files, _, err := treeToFiles(repo, tree, aURL.String(), cache, opts.IsExcluded)
+	if err != nil {
+		t.Fatalf("treeToFiles: %v", err)
+	}
+
+	var paths []string
+	for k := range files {
+		paths = append(paths, k.FullPath())
+	}
+	sort.Strings(paths)
+
+	want := []string{"afile", "bname/bfile"}
+	if d := cmp.Diff(want, paths); d != "" {
+		t.Errorf("mismatch (-want +got):\n%s", d)
+	}
+
+	// The filters are part of the index options, so changing them triggers
+	// a reindex.
+	before := opts.GetHash()
+	opts.IncludeFiles = append(opts.IncludeFiles, "subdir/**")
+	if opts.GetHash() == before {
+		t.Error("hash did not change with the include patterns")
+	}
+}
+
 func TestSubmoduleIndex(t *testing.T) {
 	dir := t.TempDir()
 
@@ -0,0 +1,126 @@
+// Package client implements a zoekt.Streamer which searches a remote
+// zoekt-webserver over its gRPC WebserverService.
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/sourcegraph/zoekt"
+	"github.com/sourcegraph/zoekt/grpc/messagesize"
+	proto "github.com/sourcegraph/zoekt/grpc/protos/zoekt/webserver/v1"
+	"github.com/sourcegraph/zoekt/internal/clientid"
+	"github.com/sourcegraph/zoekt/query"
+)
+
+// Client is a zoekt.Streamer backed by a remote zoekt-webserver. Do not
+// create directly, call Dial or New.
+type Client struct {
+	addr   string
+	client proto.WebserverServiceClient
+
+	// conn is nil if the client was created with New.
+	conn *grpc.ClientConn
+}
+
+// Dial returns a Client for the zoekt-webserver listening on addr. The
+// connection is established lazily. opts are appended to the default dial
+// options, which use an insecure transport.
+func Dial(addr string, opts ...grpc.DialOption) (*Client, error) {
+	dialOpts := []grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	}
+	dialOpts = append(dialOpts, opts...)
+
+	// The message size options are an escape hatch, so they take precedence
+	// over everything else.
+	dialOpts = append(dialOpts, messagesize.MustGetClientMessageSizeFromEnv()...)
+
+	conn, err := grpc.Dial(addr, dialOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("dialing %q: %w", addr, err)
+	}
+
+	c := New(addr, proto.NewWebserverServiceClient(conn))
+	c.conn = conn
+	return c, nil
+}
+
+// New returns a Client which uses client to talk to the zoekt-webserver at
+// addr. addr is only used to describe the client.
+func New(addr string, client proto.WebserverServiceClient) *Client {
+	return &Client{
+		addr:   addr,
+		client: client,
+	}
+}
+
+func (c *Client) Search(ctx context.Context, q query.Q, opts *zoekt.SearchOptions) (*zoekt.SearchResult, error) {
+	resp, err := c.client.Search(clientid.OutgoingContext(ctx), &proto.SearchRequest{
+		Query: query.QToProto(q),
+		Opts:  opts.ToProto(),
+	})
+	if err != nil {
+		return nil, contextError(ctx, err)
+	}
+	return zoekt.SearchResultFromProto(resp, nil, nil), nil
+}
+
+func (c *Client) StreamSearch(ctx context.Context, q query.Q, opts *zoekt.SearchOptions, sender zoekt.Sender) error {
+	ss, err := c.client.StreamSearch(clientid.OutgoingContext(ctx), &proto.StreamSearchRequest{
+		Request: &proto.SearchRequest{
+			Query: query.QToProto(q),
+			Opts:  opts.ToProto(),
+		},
+	})
+	if err != nil {
+		return contextError(ctx, err)
+	}
+
+	for {
+		resp, err := ss.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return contextError(ctx, err)
+		}
+		sender.Send(zoekt.SearchResultFromStreamProto(resp, nil, nil))
+	}
+}
+
+func (c *Client) List(ctx context.Context, q query.Q, opts *zoekt.ListOptions) (*zoekt.RepoList, error) {
+	resp, err := c.client.List(clientid.OutgoingContext(ctx), &proto.ListRequest{
+		Query: query.QToProto(q),
+		Opts:  opts.ToProto(),
+	})
+	if err != nil {
+		return nil, contextError(ctx, err)
+	}
+	return zoekt.RepoListFromProto(resp), nil
+}
+
+// Close closes the connection if it was created by Dial.
+func (c *Client) Close() {
+	if c.conn != nil {
+		_ = c.conn.Close()
+	}
+}
+
+func (c *Client) String() string {
+	return fmt.Sprintf("grpcClient(%s)", c.addr)
+}
+
+// contextError returns the error of ctx if it is done, since the gRPC status
+// errors for cancelled calls don't wrap it.
+func contextError(ctx context.Context, err error) error {
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return ctxErr
+	}
+	return err
+}
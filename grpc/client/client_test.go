@@ -0,0 +1,111 @@
+package client
+
+import (
+	"context"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+	"google.golang.org/grpc"
+
+	"github.com/sourcegraph/zoekt"
+	"github.com/sourcegraph/zoekt/cmd/zoekt-webserver/grpc/server"
+	proto "github.com/sourcegraph/zoekt/grpc/protos/zoekt/webserver/v1"
+	"github.com/sourcegraph/zoekt/internal/mockSearcher"
+	"github.com/sourcegraph/zoekt/query"
+	"github.com/sourcegraph/zoekt/stream"
+)
+
+func TestClient(t *testing.T) {
+	mock := &mockSearcher.MockSearcher{
+		WantSearch: &query.Substring{Pattern: "hello"},
+		SearchResult: &zoekt.SearchResult{
+			Stats: zoekt.Stats{MatchCount: 2, FileCount: 2},
+			Files: []zoekt.FileMatch{
+				{FileName: "bin.go", Repository: "foo/bar"},
+				{FileName: "foo.go", Repository: "foo/bar"},
+			},
+		},
+
+		WantList: &query.Const{Value: true},
+		RepoList: &zoekt.RepoList{
+			Repos: []*zoekt.RepoListEntry{{
+				Repository: zoekt.Repository{ID: 2, Name: "foo/bar"},
+			}},
+		},
+	}
+
+	gs := grpc.NewServer()
+	defer gs.Stop()
+	proto.RegisterWebserverServiceServer(gs, server.NewServer(adapter{mock}))
+	ts := httptest.NewServer(h2c.NewHandler(gs, &http2.Server{}))
+	defer ts.Close()
+
+	u, err := url.Parse(ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := Dial(u.Host)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	ctx := context.Background()
+
+	sr, err := c.Search(ctx, mock.WantSearch, &zoekt.SearchOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d := cmp.Diff(fileNames(mock.SearchResult), fileNames(sr)); d != "" {
+		t.Errorf("Search mismatch (-want +got):\n%s", d)
+	}
+
+	var streamed []string
+	err = c.StreamSearch(ctx, mock.WantSearch, &zoekt.SearchOptions{}, stream.SenderFunc(func(r *zoekt.SearchResult) {
+		streamed = append(streamed, fileNames(r)...)
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d := cmp.Diff(fileNames(mock.SearchResult), streamed); d != "" {
+		t.Errorf("StreamSearch mismatch (-want +got):\n%s", d)
+	}
+
+	rl, err := c.List(ctx, mock.WantList, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rl.Repos) != 1 || rl.Repos[0].Repository.Name != "foo/bar" {
+		t.Errorf("unexpected repos %v", rl.Repos)
+	}
+
+	// Errors of the server are returned.
+	if _, err := c.Search(ctx, &query.Substring{Pattern: "other"}, &zoekt.SearchOptions{}); err == nil {
+		t.Error("expected error for unexpected query")
+	}
+}
+
+func fileNames(sr *zoekt.SearchResult) []string {
+	var names []string
+	for _, f := range sr.Files {
+		names = append(names, f.FileName)
+	}
+	return names
+}
+
+type adapter struct {
+	zoekt.Searcher
+}
+
+func (a adapter) StreamSearch(ctx context.Context, q query.Q, opts *zoekt.SearchOptions, sender zoekt.Sender) (err error) {
+	sr, err := a.Searcher.Search(ctx, q, opts)
+	if err != nil {
+		return err
+	}
+	sender.Send(sr)
+	return nil
+}
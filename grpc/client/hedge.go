@@ -0,0 +1,297 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/sourcegraph/zoekt"
+	"github.com/sourcegraph/zoekt/query"
+	"github.com/sourcegraph/zoekt/stream"
+)
+
+var (
+	metricHedgesFiredTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "zoekt_grpc_hedges_fired_total",
+		Help: "The total number of hedge requests sent to a second replica because the first one was slow",
+	}, []string{"group", "method"})
+	metricHedgesWonTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "zoekt_grpc_hedges_won_total",
+		Help: "The total number of hedge requests which answered before the request they hedged",
+	}, []string{"group", "method"})
+)
+
+// HedgeOptions configures when a ReplicaGroup sends a hedge request.
+type HedgeOptions struct {
+	// Percentile of the recent latencies of the group, in (0, 1], after which
+	// a hedge request is sent to another replica.
+	Percentile float64
+
+	// MinDelay is a lower bound on the delay before a hedge request. It is
+	// also the delay used until enough latencies have been observed.
+	MinDelay time.Duration
+}
+
+// DefaultHedgeOptions hedges the slowest 5% of requests.
+var DefaultHedgeOptions = HedgeOptions{
+	Percentile: 0.95,
+	MinDelay:   50 * time.Millisecond,
+}
+
+// ReplicaGroup is a zoekt.Streamer over replicas which hold the same shards.
+// Each request goes to one replica, chosen round-robin. If that replica has
+// not answered after the configured latency percentile, a hedge request is
+// sent to the next replica. The first replica to answer wins and the other
+// request is cancelled. A replica which fails before answering is failed
+// over to the next replica immediately, unless it rejected the request as
+// invalid.
+//
+// For StreamSearch "answering" means sending the first result. Once a
+// replica has sent a result we are committed to it.
+type ReplicaGroup struct {
+	name     string
+	replicas []zoekt.Streamer
+	opts     HedgeOptions
+
+	next      atomic.Uint32
+	latencies *latencyWindow
+}
+
+// NewReplicaGroup returns a ReplicaGroup over replicas. name is used in
+// metrics.
+func NewReplicaGroup(name string, replicas []zoekt.Streamer, opts HedgeOptions) *ReplicaGroup {
+	return &ReplicaGroup{
+		name:      name,
+		replicas:  replicas,
+		opts:      opts,
+		latencies: newLatencyWindow(1000),
+	}
+}
+
+func (g *ReplicaGroup) Search(ctx context.Context, q query.Q, opts *zoekt.SearchOptions) (*zoekt.SearchResult, error) {
+	var sr *zoekt.SearchResult
+	err := g.hedge(ctx, "Search", func(ctx context.Context, s zoekt.Streamer, commit func() bool) error {
+		res, err := s.Search(ctx, q, opts)
+		if err != nil {
+			return err
+		}
+		if commit() {
+			sr = res
+		}
+		return nil
+	})
+	return sr, err
+}
+
+func (g *ReplicaGroup) StreamSearch(ctx context.Context, q query.Q, opts *zoekt.SearchOptions, sender zoekt.Sender) error {
+	return g.hedge(ctx, "StreamSearch", func(ctx context.Context, s zoekt.Streamer, commit func() bool) error {
+		return s.StreamSearch(ctx, q, opts, stream.SenderFunc(func(r *zoekt.SearchResult) {
+			if commit() {
+				sender.Send(r)
+			}
+		}))
+	})
+}
+
+func (g *ReplicaGroup) List(ctx context.Context, q query.Q, opts *zoekt.ListOptions) (*zoekt.RepoList, error) {
+	var rl *zoekt.RepoList
+	err := g.hedge(ctx, "List", func(ctx context.Context, s zoekt.Streamer, commit func() bool) error {
+		res, err := s.List(ctx, q, opts)
+		if err != nil {
+			return err
+		}
+		if commit() {
+			rl = res
+		}
+		return nil
+	})
+	return rl, err
+}
+
+func (g *ReplicaGroup) Close() {
+	for _, r := range g.replicas {
+		r.Close()
+	}
+}
+
+func (g *ReplicaGroup) String() string {
+	return fmt.Sprintf("ReplicaGroup(%s)", g.name)
+}
+
+// hedge runs attempt against one or more replicas and returns the error of
+// the attempt which wins. An attempt calls commit before it produces any
+// output. commit returns false if another attempt has already won, in which
+// case the output must be dropped.
+func (g *ReplicaGroup) hedge(ctx context.Context, method string, attempt func(ctx context.Context, s zoekt.Streamer, commit func() bool) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	first := int(g.next.Add(1))
+
+	type result struct {
+		n   int
+		err error
+	}
+
+	var (
+		mu      sync.Mutex
+		winner  = -1
+		cancels []context.CancelFunc
+		results = make(chan result, len(g.replicas))
+	)
+
+	commitFor := func(n int, start time.Time) func() bool {
+		return func() bool {
+			mu.Lock()
+			defer mu.Unlock()
+			if winner < 0 {
+				winner = n
+				g.latencies.observe(time.Since(start))
+				for i, c := range cancels {
+					if i != n {
+						c()
+					}
+				}
+				if n > 0 {
+					metricHedgesWonTotal.WithLabelValues(g.name, method).Inc()
+				}
+			}
+			return winner == n
+		}
+	}
+
+	launch := func() {
+		actx, acancel := context.WithCancel(ctx)
+		mu.Lock()
+		n := len(cancels)
+		cancels = append(cancels, acancel)
+		mu.Unlock()
+
+		s := g.replicas[(first+n)%len(g.replicas)]
+		commit := commitFor(n, time.Now())
+		go func() {
+			err := attempt(actx, s, commit)
+			if err == nil {
+				// An attempt which succeeds without output still answered.
+				commit()
+			}
+			results <- result{n: n, err: err}
+		}()
+	}
+
+	launched := 0
+	launch()
+	launched++
+
+	timer := time.NewTimer(g.hedgeDelay())
+	defer timer.Stop()
+
+	var firstErr error
+	for done := 0; done < launched; {
+		select {
+		case <-timer.C:
+			mu.Lock()
+			undecided := winner < 0
+			mu.Unlock()
+			if undecided && launched < len(g.replicas) {
+				metricHedgesFiredTotal.WithLabelValues(g.name, method).Inc()
+				launch()
+				launched++
+			}
+
+		case r := <-results:
+			done++
+
+			mu.Lock()
+			w := winner
+			mu.Unlock()
+
+			if r.n == w {
+				return r.err
+			}
+			if r.err != nil && firstErr == nil {
+				firstErr = r.err
+			}
+
+			// Another replica would reject the request as well.
+			if status.Code(r.err) == codes.InvalidArgument {
+				return r.err
+			}
+
+			// The replica failed before answering, so try the next one right
+			// away instead of waiting for the hedge delay.
+			if w < 0 && launched < len(g.replicas) && ctx.Err() == nil {
+				launch()
+				launched++
+			}
+		}
+	}
+
+	return firstErr
+}
+
+// hedgeDelay is how long to wait for a replica before sending a hedge
+// request.
+func (g *ReplicaGroup) hedgeDelay() time.Duration {
+	d, ok := g.latencies.percentile(g.opts.Percentile)
+	if !ok || d < g.opts.MinDelay {
+		return g.opts.MinDelay
+	}
+	return d
+}
+
+// minLatencySamples is the number of latencies we need to observe before we
+// trust their percentiles.
+const minLatencySamples = 20
+
+// latencyWindow keeps the last size observed latencies.
+type latencyWindow struct {
+	mu      sync.Mutex
+	samples []time.Duration
+	next    int
+}
+
+func newLatencyWindow(size int) *latencyWindow {
+	return &latencyWindow{samples: make([]time.Duration, 0, size)}
+}
+
+func (w *latencyWindow) observe(d time.Duration) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if len(w.samples) < cap(w.samples) {
+		w.samples = append(w.samples, d)
+		return
+	}
+	w.samples[w.next] = d
+	w.next = (w.next + 1) % len(w.samples)
+}
+
+// percentile returns the p-th percentile of the observed latencies. ok is
+// false if we haven't observed enough latencies yet.
+func (w *latencyWindow) percentile(p float64) (_ time.Duration, ok bool) {
+	w.mu.Lock()
+	if len(w.samples) < minLatencySamples {
+		w.mu.Unlock()
+		return 0, false
+	}
+	sorted := append([]time.Duration(nil), w.samples...)
+	w.mu.Unlock()
+
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	i := int(math.Ceil(p*float64(len(sorted)))) - 1
+	if i < 0 {
+		i = 0
+	} else if i >= len(sorted) {
+		i = len(sorted) - 1
+	}
+	return sorted[i], true
+}
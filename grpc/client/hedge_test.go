@@ -0,0 +1,154 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/sourcegraph/zoekt"
+	"github.com/sourcegraph/zoekt/query"
+	"github.com/sourcegraph/zoekt/stream"
+)
+
+// fakeReplica answers after delay with a single file named name.
+type fakeReplica struct {
+	name      string
+	delay     time.Duration
+	err       error
+	cancelled atomic.Bool
+}
+
+func (r *fakeReplica) wait(ctx context.Context) error {
+	select {
+	case <-time.After(r.delay):
+		return r.err
+	case <-ctx.Done():
+		r.cancelled.Store(true)
+		return ctx.Err()
+	}
+}
+
+func (r *fakeReplica) result() *zoekt.SearchResult {
+	return &zoekt.SearchResult{Files: []zoekt.FileMatch{{FileName: r.name}}}
+}
+
+func (r *fakeReplica) Search(ctx context.Context, q query.Q, opts *zoekt.SearchOptions) (*zoekt.SearchResult, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.result(), nil
+}
+
+func (r *fakeReplica) StreamSearch(ctx context.Context, q query.Q, opts *zoekt.SearchOptions, sender zoekt.Sender) error {
+	if err := r.wait(ctx); err != nil {
+		return err
+	}
+	sender.Send(r.result())
+	return nil
+}
+
+func (r *fakeReplica) List(ctx context.Context, q query.Q, opts *zoekt.ListOptions) (*zoekt.RepoList, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return &zoekt.RepoList{Repos: []*zoekt.RepoListEntry{{Repository: zoekt.Repository{Name: r.name}}}}, nil
+}
+
+func (*fakeReplica) Close() {}
+
+func (r *fakeReplica) String() string { return r.name }
+
+func TestReplicaGroupHedge(t *testing.T) {
+	slow := &fakeReplica{name: "slow", delay: 10 * time.Second}
+	fast := &fakeReplica{name: "fast", delay: time.Millisecond}
+
+	g := NewReplicaGroup("hedge", []zoekt.Streamer{slow, fast}, HedgeOptions{Percentile: 0.9, MinDelay: 10 * time.Millisecond})
+	// Make slow the first replica we try.
+	g.next.Store(uint32(len(g.replicas) - 1))
+
+	fired := testutil.ToFloat64(metricHedgesFiredTotal.WithLabelValues("hedge", "Search"))
+	won := testutil.ToFloat64(metricHedgesWonTotal.WithLabelValues("hedge", "Search"))
+	streamFired := testutil.ToFloat64(metricHedgesFiredTotal.WithLabelValues("hedge", "StreamSearch"))
+
+	sr, err := g.Search(context.Background(), &query.Const{Value: true}, &zoekt.SearchOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sr.Files) != 1 || sr.Files[0].FileName != "fast" {
+		t.Fatalf("got %v, want the result of the hedge request", sr.Files)
+	}
+	// The losing request is cancelled, but may take a moment to notice.
+	for i := 0; !slow.cancelled.Load(); i++ {
+		if i == 100 {
+			t.Fatal("the losing request was not cancelled")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if got := testutil.ToFloat64(metricHedgesFiredTotal.WithLabelValues("hedge", "Search")) - fired; got != 1 {
+		t.Errorf("got %v hedges fired, want 1", got)
+	}
+	if got := testutil.ToFloat64(metricHedgesWonTotal.WithLabelValues("hedge", "Search")) - won; got != 1 {
+		t.Errorf("got %v hedges won, want 1", got)
+	}
+
+	// A fast first replica doesn't need a hedge.
+	g.next.Store(0)
+	var files []string
+	err = g.StreamSearch(context.Background(), &query.Const{Value: true}, &zoekt.SearchOptions{}, stream.SenderFunc(func(r *zoekt.SearchResult) {
+		for _, f := range r.Files {
+			files = append(files, f.FileName)
+		}
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 1 || files[0] != "fast" {
+		t.Fatalf("got %v, want [fast]", files)
+	}
+	if got := testutil.ToFloat64(metricHedgesFiredTotal.WithLabelValues("hedge", "StreamSearch")) - streamFired; got != 0 {
+		t.Errorf("got %v hedges fired, want 0", got)
+	}
+}
+
+func TestReplicaGroupFailover(t *testing.T) {
+	broken := &fakeReplica{name: "broken", err: errors.New("boom")}
+	ok := &fakeReplica{name: "ok"}
+
+	// A long MinDelay makes sure we fail over without waiting for a hedge.
+	g := NewReplicaGroup("failover", []zoekt.Streamer{broken, ok}, HedgeOptions{Percentile: 0.9, MinDelay: time.Hour})
+	g.next.Store(uint32(len(g.replicas) - 1))
+
+	rl, err := g.List(context.Background(), &query.Const{Value: true}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rl.Repos) != 1 || rl.Repos[0].Repository.Name != "ok" {
+		t.Fatalf("got %v, want the result of the second replica", rl.Repos)
+	}
+
+	// If all replicas fail, we return an error.
+	g = NewReplicaGroup("failover", []zoekt.Streamer{broken, broken}, DefaultHedgeOptions)
+	if _, err := g.Search(context.Background(), &query.Const{Value: true}, &zoekt.SearchOptions{}); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestLatencyWindow(t *testing.T) {
+	w := newLatencyWindow(100)
+	if _, ok := w.percentile(0.5); ok {
+		t.Fatal("expected no percentile without samples")
+	}
+	// Overfill the window, only the last 100 samples 101..200 count.
+	for i := 1; i <= 200; i++ {
+		w.observe(time.Duration(i))
+	}
+	for p, want := range map[float64]time.Duration{0.5: 150, 0.95: 195, 1: 200} {
+		if got, _ := w.percentile(p); got != want {
+			t.Errorf("percentile(%v) = %v, want %v", p, got, want)
+		}
+	}
+}
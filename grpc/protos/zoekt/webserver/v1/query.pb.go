@@ -88,6 +88,7 @@ const (
 	Type_KIND_FILE_MATCH          Type_Kind = 1
 	Type_KIND_FILE_NAME           Type_Kind = 2
 	Type_KIND_REPO                Type_Kind = 3
+	Type_KIND_COMMIT              Type_Kind = 4
 )
 
 // Enum value maps for Type_Kind.
@@ -97,12 +98,14 @@ var (
 		1: "KIND_FILE_MATCH",
 		2: "KIND_FILE_NAME",
 		3: "KIND_REPO",
+		4: "KIND_COMMIT",
 	}
 	Type_Kind_value = map[string]int32{
 		"KIND_UNKNOWN_UNSPECIFIED": 0,
 		"KIND_FILE_MATCH":          1,
 		"KIND_FILE_NAME":           2,
 		"KIND_REPO":                3,
+		"KIND_COMMIT":              4,
 	}
 )
 
@@ -130,7 +133,7 @@ func (x Type_Kind) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Type_Kind.Descriptor instead.
 func (Type_Kind) EnumDescriptor() ([]byte, []int) {
-	return file_zoekt_webserver_v1_query_proto_rawDescGZIP(), []int{12, 0}
+	return file_zoekt_webserver_v1_query_proto_rawDescGZIP(), []int{14, 0}
 }
 
 type Q struct {
@@ -139,7 +142,6 @@ type Q struct {
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to Query:
-	//
 	//	*Q_RawConfig
 	//	*Q_Regexp
 	//	*Q_Symbol
@@ -157,6 +159,8 @@ type Q struct {
 	//	*Q_Or
 	//	*Q_Not
 	//	*Q_Branch
+	//	*Q_Reference
+	//	*Q_Author
 	Query isQ_Query `protobuf_oneof:"query"`
 }
 
@@ -318,6 +322,20 @@ func (x *Q) GetBranch() *Branch {
 	return nil
 }
 
+func (x *Q) GetReference() *Reference {
+	if x, ok := x.GetQuery().(*Q_Reference); ok {
+		return x.Reference
+	}
+	return nil
+}
+
+func (x *Q) GetAuthor() *Author {
+	if x, ok := x.GetQuery().(*Q_Author); ok {
+		return x.Author
+	}
+	return nil
+}
+
 type isQ_Query interface {
 	isQ_Query()
 }
@@ -390,6 +408,14 @@ type Q_Branch struct {
 	Branch *Branch `protobuf:"bytes,17,opt,name=branch,proto3,oneof"`
 }
 
+type Q_Reference struct {
+	Reference *Reference `protobuf:"bytes,18,opt,name=reference,proto3,oneof"`
+}
+
+type Q_Author struct {
+	Author *Author `protobuf:"bytes,19,opt,name=author,proto3,oneof"`
+}
+
 func (*Q_RawConfig) isQ_Query() {}
 
 func (*Q_Regexp) isQ_Query() {}
@@ -424,6 +450,10 @@ func (*Q_Not) isQ_Query() {}
 
 func (*Q_Branch) isQ_Query() {}
 
+func (*Q_Reference) isQ_Query() {}
+
+func (*Q_Author) isQ_Query() {}
+
 // RawConfig filters repositories based on their encoded RawConfig map.
 type RawConfig struct {
 	state         protoimpl.MessageState
@@ -591,6 +621,55 @@ func (x *Symbol) GetExpr() *Q {
 	return nil
 }
 
+// Reference matches references to symbols, e.g. calls, as opposed to their
+// definitions.
+type Reference struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Expr *Q `protobuf:"bytes,1,opt,name=expr,proto3" json:"expr,omitempty"`
+}
+
+func (x *Reference) Reset() {
+	*x = Reference{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_zoekt_webserver_v1_query_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Reference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reference) ProtoMessage() {}
+
+func (x *Reference) ProtoReflect() protoreflect.Message {
+	mi := &file_zoekt_webserver_v1_query_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reference.ProtoReflect.Descriptor instead.
+func (*Reference) Descriptor() ([]byte, []int) {
+	return file_zoekt_webserver_v1_query_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Reference) GetExpr() *Q {
+	if x != nil {
+		return x.Expr
+	}
+	return nil
+}
+
 type Language struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -602,7 +681,7 @@ type Language struct {
 func (x *Language) Reset() {
 	*x = Language{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zoekt_webserver_v1_query_proto_msgTypes[4]
+		mi := &file_zoekt_webserver_v1_query_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -615,7 +694,7 @@ func (x *Language) String() string {
 func (*Language) ProtoMessage() {}
 
 func (x *Language) ProtoReflect() protoreflect.Message {
-	mi := &file_zoekt_webserver_v1_query_proto_msgTypes[4]
+	mi := &file_zoekt_webserver_v1_query_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -628,7 +707,7 @@ func (x *Language) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Language.ProtoReflect.Descriptor instead.
 func (*Language) Descriptor() ([]byte, []int) {
-	return file_zoekt_webserver_v1_query_proto_rawDescGZIP(), []int{4}
+	return file_zoekt_webserver_v1_query_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *Language) GetLanguage() string {
@@ -638,6 +717,55 @@ func (x *Language) GetLanguage() string {
 	return ""
 }
 
+// Author matches documents whose last author, "Name <email>", matches the
+// regular expression.
+type Author struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Regexp string `protobuf:"bytes,1,opt,name=regexp,proto3" json:"regexp,omitempty"`
+}
+
+func (x *Author) Reset() {
+	*x = Author{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_zoekt_webserver_v1_query_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Author) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Author) ProtoMessage() {}
+
+func (x *Author) ProtoReflect() protoreflect.Message {
+	mi := &file_zoekt_webserver_v1_query_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Author.ProtoReflect.Descriptor instead.
+func (*Author) Descriptor() ([]byte, []int) {
+	return file_zoekt_webserver_v1_query_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Author) GetRegexp() string {
+	if x != nil {
+		return x.Regexp
+	}
+	return ""
+}
+
 type Repo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -649,7 +777,7 @@ type Repo struct {
 func (x *Repo) Reset() {
 	*x = Repo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zoekt_webserver_v1_query_proto_msgTypes[5]
+		mi := &file_zoekt_webserver_v1_query_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -662,7 +790,7 @@ func (x *Repo) String() string {
 func (*Repo) ProtoMessage() {}
 
 func (x *Repo) ProtoReflect() protoreflect.Message {
-	mi := &file_zoekt_webserver_v1_query_proto_msgTypes[5]
+	mi := &file_zoekt_webserver_v1_query_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -675,7 +803,7 @@ func (x *Repo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Repo.ProtoReflect.Descriptor instead.
 func (*Repo) Descriptor() ([]byte, []int) {
-	return file_zoekt_webserver_v1_query_proto_rawDescGZIP(), []int{5}
+	return file_zoekt_webserver_v1_query_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *Repo) GetRegexp() string {
@@ -696,7 +824,7 @@ type RepoRegexp struct {
 func (x *RepoRegexp) Reset() {
 	*x = RepoRegexp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zoekt_webserver_v1_query_proto_msgTypes[6]
+		mi := &file_zoekt_webserver_v1_query_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -709,7 +837,7 @@ func (x *RepoRegexp) String() string {
 func (*RepoRegexp) ProtoMessage() {}
 
 func (x *RepoRegexp) ProtoReflect() protoreflect.Message {
-	mi := &file_zoekt_webserver_v1_query_proto_msgTypes[6]
+	mi := &file_zoekt_webserver_v1_query_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -722,7 +850,7 @@ func (x *RepoRegexp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RepoRegexp.ProtoReflect.Descriptor instead.
 func (*RepoRegexp) Descriptor() ([]byte, []int) {
-	return file_zoekt_webserver_v1_query_proto_rawDescGZIP(), []int{6}
+	return file_zoekt_webserver_v1_query_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *RepoRegexp) GetRegexp() string {
@@ -744,7 +872,7 @@ type BranchesRepos struct {
 func (x *BranchesRepos) Reset() {
 	*x = BranchesRepos{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zoekt_webserver_v1_query_proto_msgTypes[7]
+		mi := &file_zoekt_webserver_v1_query_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -757,7 +885,7 @@ func (x *BranchesRepos) String() string {
 func (*BranchesRepos) ProtoMessage() {}
 
 func (x *BranchesRepos) ProtoReflect() protoreflect.Message {
-	mi := &file_zoekt_webserver_v1_query_proto_msgTypes[7]
+	mi := &file_zoekt_webserver_v1_query_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -770,7 +898,7 @@ func (x *BranchesRepos) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BranchesRepos.ProtoReflect.Descriptor instead.
 func (*BranchesRepos) Descriptor() ([]byte, []int) {
-	return file_zoekt_webserver_v1_query_proto_rawDescGZIP(), []int{7}
+	return file_zoekt_webserver_v1_query_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *BranchesRepos) GetList() []*BranchRepos {
@@ -795,7 +923,7 @@ type BranchRepos struct {
 func (x *BranchRepos) Reset() {
 	*x = BranchRepos{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zoekt_webserver_v1_query_proto_msgTypes[8]
+		mi := &file_zoekt_webserver_v1_query_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -808,7 +936,7 @@ func (x *BranchRepos) String() string {
 func (*BranchRepos) ProtoMessage() {}
 
 func (x *BranchRepos) ProtoReflect() protoreflect.Message {
-	mi := &file_zoekt_webserver_v1_query_proto_msgTypes[8]
+	mi := &file_zoekt_webserver_v1_query_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -821,7 +949,7 @@ func (x *BranchRepos) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BranchRepos.ProtoReflect.Descriptor instead.
 func (*BranchRepos) Descriptor() ([]byte, []int) {
-	return file_zoekt_webserver_v1_query_proto_rawDescGZIP(), []int{8}
+	return file_zoekt_webserver_v1_query_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *BranchRepos) GetBranch() string {
@@ -852,7 +980,7 @@ type RepoIds struct {
 func (x *RepoIds) Reset() {
 	*x = RepoIds{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zoekt_webserver_v1_query_proto_msgTypes[9]
+		mi := &file_zoekt_webserver_v1_query_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -865,7 +993,7 @@ func (x *RepoIds) String() string {
 func (*RepoIds) ProtoMessage() {}
 
 func (x *RepoIds) ProtoReflect() protoreflect.Message {
-	mi := &file_zoekt_webserver_v1_query_proto_msgTypes[9]
+	mi := &file_zoekt_webserver_v1_query_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -878,7 +1006,7 @@ func (x *RepoIds) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RepoIds.ProtoReflect.Descriptor instead.
 func (*RepoIds) Descriptor() ([]byte, []int) {
-	return file_zoekt_webserver_v1_query_proto_rawDescGZIP(), []int{9}
+	return file_zoekt_webserver_v1_query_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *RepoIds) GetRepos() []byte {
@@ -900,7 +1028,7 @@ type RepoSet struct {
 func (x *RepoSet) Reset() {
 	*x = RepoSet{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zoekt_webserver_v1_query_proto_msgTypes[10]
+		mi := &file_zoekt_webserver_v1_query_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -913,7 +1041,7 @@ func (x *RepoSet) String() string {
 func (*RepoSet) ProtoMessage() {}
 
 func (x *RepoSet) ProtoReflect() protoreflect.Message {
-	mi := &file_zoekt_webserver_v1_query_proto_msgTypes[10]
+	mi := &file_zoekt_webserver_v1_query_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -926,7 +1054,7 @@ func (x *RepoSet) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RepoSet.ProtoReflect.Descriptor instead.
 func (*RepoSet) Descriptor() ([]byte, []int) {
-	return file_zoekt_webserver_v1_query_proto_rawDescGZIP(), []int{10}
+	return file_zoekt_webserver_v1_query_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *RepoSet) GetSet() map[string]bool {
@@ -948,7 +1076,7 @@ type FileNameSet struct {
 func (x *FileNameSet) Reset() {
 	*x = FileNameSet{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zoekt_webserver_v1_query_proto_msgTypes[11]
+		mi := &file_zoekt_webserver_v1_query_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -961,7 +1089,7 @@ func (x *FileNameSet) String() string {
 func (*FileNameSet) ProtoMessage() {}
 
 func (x *FileNameSet) ProtoReflect() protoreflect.Message {
-	mi := &file_zoekt_webserver_v1_query_proto_msgTypes[11]
+	mi := &file_zoekt_webserver_v1_query_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -974,7 +1102,7 @@ func (x *FileNameSet) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FileNameSet.ProtoReflect.Descriptor instead.
 func (*FileNameSet) Descriptor() ([]byte, []int) {
-	return file_zoekt_webserver_v1_query_proto_rawDescGZIP(), []int{11}
+	return file_zoekt_webserver_v1_query_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *FileNameSet) GetSet() []string {
@@ -998,7 +1126,7 @@ type Type struct {
 func (x *Type) Reset() {
 	*x = Type{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zoekt_webserver_v1_query_proto_msgTypes[12]
+		mi := &file_zoekt_webserver_v1_query_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1011,7 +1139,7 @@ func (x *Type) String() string {
 func (*Type) ProtoMessage() {}
 
 func (x *Type) ProtoReflect() protoreflect.Message {
-	mi := &file_zoekt_webserver_v1_query_proto_msgTypes[12]
+	mi := &file_zoekt_webserver_v1_query_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1024,7 +1152,7 @@ func (x *Type) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Type.ProtoReflect.Descriptor instead.
 func (*Type) Descriptor() ([]byte, []int) {
-	return file_zoekt_webserver_v1_query_proto_rawDescGZIP(), []int{12}
+	return file_zoekt_webserver_v1_query_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *Type) GetChild() *Q {
@@ -1057,7 +1185,7 @@ type Substring struct {
 func (x *Substring) Reset() {
 	*x = Substring{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zoekt_webserver_v1_query_proto_msgTypes[13]
+		mi := &file_zoekt_webserver_v1_query_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1070,7 +1198,7 @@ func (x *Substring) String() string {
 func (*Substring) ProtoMessage() {}
 
 func (x *Substring) ProtoReflect() protoreflect.Message {
-	mi := &file_zoekt_webserver_v1_query_proto_msgTypes[13]
+	mi := &file_zoekt_webserver_v1_query_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1083,7 +1211,7 @@ func (x *Substring) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Substring.ProtoReflect.Descriptor instead.
 func (*Substring) Descriptor() ([]byte, []int) {
-	return file_zoekt_webserver_v1_query_proto_rawDescGZIP(), []int{13}
+	return file_zoekt_webserver_v1_query_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *Substring) GetPattern() string {
@@ -1126,7 +1254,7 @@ type And struct {
 func (x *And) Reset() {
 	*x = And{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zoekt_webserver_v1_query_proto_msgTypes[14]
+		mi := &file_zoekt_webserver_v1_query_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1139,7 +1267,7 @@ func (x *And) String() string {
 func (*And) ProtoMessage() {}
 
 func (x *And) ProtoReflect() protoreflect.Message {
-	mi := &file_zoekt_webserver_v1_query_proto_msgTypes[14]
+	mi := &file_zoekt_webserver_v1_query_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1152,7 +1280,7 @@ func (x *And) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use And.ProtoReflect.Descriptor instead.
 func (*And) Descriptor() ([]byte, []int) {
-	return file_zoekt_webserver_v1_query_proto_rawDescGZIP(), []int{14}
+	return file_zoekt_webserver_v1_query_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *And) GetChildren() []*Q {
@@ -1174,7 +1302,7 @@ type Or struct {
 func (x *Or) Reset() {
 	*x = Or{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zoekt_webserver_v1_query_proto_msgTypes[15]
+		mi := &file_zoekt_webserver_v1_query_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1187,7 +1315,7 @@ func (x *Or) String() string {
 func (*Or) ProtoMessage() {}
 
 func (x *Or) ProtoReflect() protoreflect.Message {
-	mi := &file_zoekt_webserver_v1_query_proto_msgTypes[15]
+	mi := &file_zoekt_webserver_v1_query_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1200,7 +1328,7 @@ func (x *Or) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Or.ProtoReflect.Descriptor instead.
 func (*Or) Descriptor() ([]byte, []int) {
-	return file_zoekt_webserver_v1_query_proto_rawDescGZIP(), []int{15}
+	return file_zoekt_webserver_v1_query_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *Or) GetChildren() []*Q {
@@ -1222,7 +1350,7 @@ type Not struct {
 func (x *Not) Reset() {
 	*x = Not{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zoekt_webserver_v1_query_proto_msgTypes[16]
+		mi := &file_zoekt_webserver_v1_query_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1235,7 +1363,7 @@ func (x *Not) String() string {
 func (*Not) ProtoMessage() {}
 
 func (x *Not) ProtoReflect() protoreflect.Message {
-	mi := &file_zoekt_webserver_v1_query_proto_msgTypes[16]
+	mi := &file_zoekt_webserver_v1_query_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1248,7 +1376,7 @@ func (x *Not) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Not.ProtoReflect.Descriptor instead.
 func (*Not) Descriptor() ([]byte, []int) {
-	return file_zoekt_webserver_v1_query_proto_rawDescGZIP(), []int{16}
+	return file_zoekt_webserver_v1_query_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *Not) GetChild() *Q {
@@ -1272,7 +1400,7 @@ type Branch struct {
 func (x *Branch) Reset() {
 	*x = Branch{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zoekt_webserver_v1_query_proto_msgTypes[17]
+		mi := &file_zoekt_webserver_v1_query_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1285,7 +1413,7 @@ func (x *Branch) String() string {
 func (*Branch) ProtoMessage() {}
 
 func (x *Branch) ProtoReflect() protoreflect.Message {
-	mi := &file_zoekt_webserver_v1_query_proto_msgTypes[17]
+	mi := &file_zoekt_webserver_v1_query_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1298,7 +1426,7 @@ func (x *Branch) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Branch.ProtoReflect.Descriptor instead.
 func (*Branch) Descriptor() ([]byte, []int) {
-	return file_zoekt_webserver_v1_query_proto_rawDescGZIP(), []int{17}
+	return file_zoekt_webserver_v1_query_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *Branch) GetPattern() string {
@@ -1321,7 +1449,7 @@ var file_zoekt_webserver_v1_query_proto_rawDesc = []byte{
 	0x0a, 0x1e, 0x7a, 0x6f, 0x65, 0x6b, 0x74, 0x2f, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65,
 	0x72, 0x2f, 0x76, 0x31, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x12, 0x12, 0x7a, 0x6f, 0x65, 0x6b, 0x74, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2e, 0x76, 0x31, 0x22, 0xaf, 0x07, 0x0a, 0x01, 0x51, 0x12, 0x3e, 0x0a, 0x0a, 0x72, 0x61,
+	0x72, 0x2e, 0x76, 0x31, 0x22, 0xa4, 0x08, 0x0a, 0x01, 0x51, 0x12, 0x3e, 0x0a, 0x0a, 0x72, 0x61,
 	0x77, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
 	0x2e, 0x7a, 0x6f, 0x65, 0x6b, 0x74, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
 	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x77, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52,
@@ -1379,74 +1507,88 @@ var file_zoekt_webserver_v1_query_proto_rawDesc = []byte{
 	0x48, 0x00, 0x52, 0x03, 0x6e, 0x6f, 0x74, 0x12, 0x34, 0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63,
 	0x68, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x7a, 0x6f, 0x65, 0x6b, 0x74, 0x2e,
 	0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x72, 0x61,
-	0x6e, 0x63, 0x68, 0x48, 0x00, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x42, 0x07, 0x0a,
-	0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x22, 0xef, 0x01, 0x0a, 0x09, 0x52, 0x61, 0x77, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x12, 0x38, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x7a, 0x6f, 0x65, 0x6b, 0x74, 0x2e, 0x77, 0x65, 0x62, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x77, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x2e, 0x46, 0x6c, 0x61, 0x67, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x22, 0xa7,
-	0x01, 0x0a, 0x04, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x1c, 0x0a, 0x18, 0x46, 0x4c, 0x41, 0x47, 0x5f,
-	0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
-	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x46, 0x4c, 0x41, 0x47, 0x5f, 0x4f, 0x4e,
-	0x4c, 0x59, 0x5f, 0x50, 0x55, 0x42, 0x4c, 0x49, 0x43, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x46,
-	0x4c, 0x41, 0x47, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x5f, 0x50, 0x52, 0x49, 0x56, 0x41, 0x54, 0x45,
-	0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x46, 0x4c, 0x41, 0x47, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x5f,
-	0x46, 0x4f, 0x52, 0x4b, 0x53, 0x10, 0x04, 0x12, 0x11, 0x0a, 0x0d, 0x46, 0x4c, 0x41, 0x47, 0x5f,
-	0x4e, 0x4f, 0x5f, 0x46, 0x4f, 0x52, 0x4b, 0x53, 0x10, 0x08, 0x12, 0x16, 0x0a, 0x12, 0x46, 0x4c,
-	0x41, 0x47, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x5f, 0x41, 0x52, 0x43, 0x48, 0x49, 0x56, 0x45, 0x44,
-	0x10, 0x10, 0x12, 0x14, 0x0a, 0x10, 0x46, 0x4c, 0x41, 0x47, 0x5f, 0x4e, 0x4f, 0x5f, 0x41, 0x52,
-	0x43, 0x48, 0x49, 0x56, 0x45, 0x44, 0x10, 0x20, 0x22, 0x7e, 0x0a, 0x06, 0x52, 0x65, 0x67, 0x65,
+	0x6e, 0x63, 0x68, 0x48, 0x00, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x3d, 0x0a,
+	0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x7a, 0x6f, 0x65, 0x6b, 0x74, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x48,
+	0x00, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x34, 0x0a, 0x06,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x7a,
+	0x6f, 0x65, 0x6b, 0x74, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x42, 0x07, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x22, 0xef, 0x01, 0x0a, 0x09,
+	0x52, 0x61, 0x77, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x38, 0x0a, 0x05, 0x66, 0x6c, 0x61,
+	0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x7a, 0x6f, 0x65, 0x6b, 0x74,
+	0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61,
+	0x77, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x46, 0x6c, 0x61, 0x67, 0x52, 0x05, 0x66, 0x6c,
+	0x61, 0x67, 0x73, 0x22, 0xa7, 0x01, 0x0a, 0x04, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x1c, 0x0a, 0x18,
+	0x46, 0x4c, 0x41, 0x47, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x46, 0x4c,
+	0x41, 0x47, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x5f, 0x50, 0x55, 0x42, 0x4c, 0x49, 0x43, 0x10, 0x01,
+	0x12, 0x15, 0x0a, 0x11, 0x46, 0x4c, 0x41, 0x47, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x5f, 0x50, 0x52,
+	0x49, 0x56, 0x41, 0x54, 0x45, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x46, 0x4c, 0x41, 0x47, 0x5f,
+	0x4f, 0x4e, 0x4c, 0x59, 0x5f, 0x46, 0x4f, 0x52, 0x4b, 0x53, 0x10, 0x04, 0x12, 0x11, 0x0a, 0x0d,
+	0x46, 0x4c, 0x41, 0x47, 0x5f, 0x4e, 0x4f, 0x5f, 0x46, 0x4f, 0x52, 0x4b, 0x53, 0x10, 0x08, 0x12,
+	0x16, 0x0a, 0x12, 0x46, 0x4c, 0x41, 0x47, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x5f, 0x41, 0x52, 0x43,
+	0x48, 0x49, 0x56, 0x45, 0x44, 0x10, 0x10, 0x12, 0x14, 0x0a, 0x10, 0x46, 0x4c, 0x41, 0x47, 0x5f,
+	0x4e, 0x4f, 0x5f, 0x41, 0x52, 0x43, 0x48, 0x49, 0x56, 0x45, 0x44, 0x10, 0x20, 0x22, 0x7e, 0x0a,
+	0x06, 0x52, 0x65, 0x67, 0x65, 0x78, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x65, 0x78,
+	0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x65, 0x78, 0x70, 0x12,
+	0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x61, 0x73, 0x65, 0x5f, 0x73,
+	0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d,
+	0x63, 0x61, 0x73, 0x65, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x22, 0x33, 0x0a,
+	0x06, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x29, 0x0a, 0x04, 0x65, 0x78, 0x70, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x7a, 0x6f, 0x65, 0x6b, 0x74, 0x2e, 0x77, 0x65,
+	0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x52, 0x04, 0x65, 0x78,
+	0x70, 0x72, 0x22, 0x36, 0x0a, 0x09, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12,
+	0x29, 0x0a, 0x04, 0x65, 0x78, 0x70, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x7a, 0x6f, 0x65, 0x6b, 0x74, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x51, 0x52, 0x04, 0x65, 0x78, 0x70, 0x72, 0x22, 0x26, 0x0a, 0x08, 0x4c, 0x61,
+	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61,
+	0x67, 0x65, 0x22, 0x20, 0x0a, 0x06, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x67, 0x65, 0x78, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
+	0x67, 0x65, 0x78, 0x70, 0x22, 0x1e, 0x0a, 0x04, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x67, 0x65, 0x78, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
+	0x67, 0x65, 0x78, 0x70, 0x22, 0x24, 0x0a, 0x0a, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x67, 0x65,
 	0x78, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x65, 0x78, 0x70, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x65, 0x78, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69,
-	0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x66,
-	0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
-	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x61, 0x73, 0x65, 0x5f, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74,
-	0x69, 0x76, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x63, 0x61, 0x73, 0x65, 0x53,
-	0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x22, 0x33, 0x0a, 0x06, 0x53, 0x79, 0x6d, 0x62,
-	0x6f, 0x6c, 0x12, 0x29, 0x0a, 0x04, 0x65, 0x78, 0x70, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x15, 0x2e, 0x7a, 0x6f, 0x65, 0x6b, 0x74, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x52, 0x04, 0x65, 0x78, 0x70, 0x72, 0x22, 0x26, 0x0a,
-	0x08, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e,
-	0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e,
-	0x67, 0x75, 0x61, 0x67, 0x65, 0x22, 0x1e, 0x0a, 0x04, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x16, 0x0a,
-	0x06, 0x72, 0x65, 0x67, 0x65, 0x78, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
-	0x65, 0x67, 0x65, 0x78, 0x70, 0x22, 0x24, 0x0a, 0x0a, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x67,
-	0x65, 0x78, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x65, 0x78, 0x70, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x65, 0x78, 0x70, 0x22, 0x44, 0x0a, 0x0d, 0x42,
-	0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x12, 0x33, 0x0a, 0x04,
-	0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x7a, 0x6f, 0x65,
-	0x6b, 0x74, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
-	0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x52, 0x04, 0x6c, 0x69, 0x73,
-	0x74, 0x22, 0x3b, 0x0a, 0x0b, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6f, 0x73,
-	0x12, 0x16, 0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x70, 0x6f,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x22, 0x1f,
-	0x0a, 0x07, 0x52, 0x65, 0x70, 0x6f, 0x49, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x70,
-	0x6f, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x22,
-	0x79, 0x0a, 0x07, 0x52, 0x65, 0x70, 0x6f, 0x53, 0x65, 0x74, 0x12, 0x36, 0x0a, 0x03, 0x73, 0x65,
-	0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x7a, 0x6f, 0x65, 0x6b, 0x74, 0x2e,
-	0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70,
-	0x6f, 0x53, 0x65, 0x74, 0x2e, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x03, 0x73,
-	0x65, 0x74, 0x1a, 0x36, 0x0a, 0x08, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x1f, 0x0a, 0x0b, 0x46, 0x69,
-	0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x53, 0x65, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x74,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x73, 0x65, 0x74, 0x22, 0xc4, 0x01, 0x0a, 0x04,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x2b, 0x0a, 0x05, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x7a, 0x6f, 0x65, 0x6b, 0x74, 0x2e, 0x77, 0x65, 0x62, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x52, 0x05, 0x63, 0x68, 0x69, 0x6c,
-	0x64, 0x12, 0x31, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x1d, 0x2e, 0x7a, 0x6f, 0x65, 0x6b, 0x74, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04,
-	0x74, 0x79, 0x70, 0x65, 0x22, 0x5c, 0x0a, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1c, 0x0a, 0x18,
-	0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x55, 0x4e, 0x53,
-	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x4b, 0x49,
-	0x4e, 0x44, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x10, 0x01, 0x12,
-	0x12, 0x0a, 0x0e, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x4e, 0x41, 0x4d,
-	0x45, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x52, 0x45, 0x50, 0x4f,
-	0x10, 0x03, 0x22, 0x83, 0x01, 0x0a, 0x09, 0x53, 0x75, 0x62, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x65, 0x78, 0x70, 0x22, 0x44, 0x0a, 0x0d, 0x42, 0x72,
+	0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x12, 0x33, 0x0a, 0x04, 0x6c,
+	0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x7a, 0x6f, 0x65, 0x6b,
+	0x74, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42,
+	0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74,
+	0x22, 0x3b, 0x0a, 0x0b, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x70, 0x6f, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x22, 0x1f, 0x0a,
+	0x07, 0x52, 0x65, 0x70, 0x6f, 0x49, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x70, 0x6f,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x22, 0x79,
+	0x0a, 0x07, 0x52, 0x65, 0x70, 0x6f, 0x53, 0x65, 0x74, 0x12, 0x36, 0x0a, 0x03, 0x73, 0x65, 0x74,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x7a, 0x6f, 0x65, 0x6b, 0x74, 0x2e, 0x77,
+	0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f,
+	0x53, 0x65, 0x74, 0x2e, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x03, 0x73, 0x65,
+	0x74, 0x1a, 0x36, 0x0a, 0x08, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x1f, 0x0a, 0x0b, 0x46, 0x69, 0x6c,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x53, 0x65, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x74, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x73, 0x65, 0x74, 0x22, 0xd5, 0x01, 0x0a, 0x04, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x2b, 0x0a, 0x05, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x7a, 0x6f, 0x65, 0x6b, 0x74, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x52, 0x05, 0x63, 0x68, 0x69, 0x6c, 0x64,
+	0x12, 0x31, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d,
+	0x2e, 0x7a, 0x6f, 0x65, 0x6b, 0x74, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x22, 0x6d, 0x0a, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1c, 0x0a, 0x18, 0x4b,
+	0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x4b, 0x49, 0x4e,
+	0x44, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x10, 0x01, 0x12, 0x12,
+	0x0a, 0x0e, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x4e, 0x41, 0x4d, 0x45,
+	0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x52, 0x45, 0x50, 0x4f, 0x10,
+	0x03, 0x12, 0x0f, 0x0a, 0x0b, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54,
+	0x10, 0x04, 0x22, 0x83, 0x01, 0x0a, 0x09, 0x53, 0x75, 0x62, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
 	0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x61,
 	0x73, 0x65, 0x5f, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01,
@@ -1488,7 +1630,7 @@ func file_zoekt_webserver_v1_query_proto_rawDescGZIP() []byte {
 }
 
 var file_zoekt_webserver_v1_query_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_zoekt_webserver_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_zoekt_webserver_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
 var file_zoekt_webserver_v1_query_proto_goTypes = []interface{}{
 	(RawConfig_Flag)(0),   // 0: zoekt.webserver.v1.RawConfig.Flag
 	(Type_Kind)(0),        // 1: zoekt.webserver.v1.Type.Kind
@@ -1496,53 +1638,58 @@ var file_zoekt_webserver_v1_query_proto_goTypes = []interface{}{
 	(*RawConfig)(nil),     // 3: zoekt.webserver.v1.RawConfig
 	(*Regexp)(nil),        // 4: zoekt.webserver.v1.Regexp
 	(*Symbol)(nil),        // 5: zoekt.webserver.v1.Symbol
-	(*Language)(nil),      // 6: zoekt.webserver.v1.Language
-	(*Repo)(nil),          // 7: zoekt.webserver.v1.Repo
-	(*RepoRegexp)(nil),    // 8: zoekt.webserver.v1.RepoRegexp
-	(*BranchesRepos)(nil), // 9: zoekt.webserver.v1.BranchesRepos
-	(*BranchRepos)(nil),   // 10: zoekt.webserver.v1.BranchRepos
-	(*RepoIds)(nil),       // 11: zoekt.webserver.v1.RepoIds
-	(*RepoSet)(nil),       // 12: zoekt.webserver.v1.RepoSet
-	(*FileNameSet)(nil),   // 13: zoekt.webserver.v1.FileNameSet
-	(*Type)(nil),          // 14: zoekt.webserver.v1.Type
-	(*Substring)(nil),     // 15: zoekt.webserver.v1.Substring
-	(*And)(nil),           // 16: zoekt.webserver.v1.And
-	(*Or)(nil),            // 17: zoekt.webserver.v1.Or
-	(*Not)(nil),           // 18: zoekt.webserver.v1.Not
-	(*Branch)(nil),        // 19: zoekt.webserver.v1.Branch
-	nil,                   // 20: zoekt.webserver.v1.RepoSet.SetEntry
+	(*Reference)(nil),     // 6: zoekt.webserver.v1.Reference
+	(*Language)(nil),      // 7: zoekt.webserver.v1.Language
+	(*Author)(nil),        // 8: zoekt.webserver.v1.Author
+	(*Repo)(nil),          // 9: zoekt.webserver.v1.Repo
+	(*RepoRegexp)(nil),    // 10: zoekt.webserver.v1.RepoRegexp
+	(*BranchesRepos)(nil), // 11: zoekt.webserver.v1.BranchesRepos
+	(*BranchRepos)(nil),   // 12: zoekt.webserver.v1.BranchRepos
+	(*RepoIds)(nil),       // 13: zoekt.webserver.v1.RepoIds
+	(*RepoSet)(nil),       // 14: zoekt.webserver.v1.RepoSet
+	(*FileNameSet)(nil),   // 15: zoekt.webserver.v1.FileNameSet
+	(*Type)(nil),          // 16: zoekt.webserver.v1.Type
+	(*Substring)(nil),     // 17: zoekt.webserver.v1.Substring
+	(*And)(nil),           // 18: zoekt.webserver.v1.And
+	(*Or)(nil),            // 19: zoekt.webserver.v1.Or
+	(*Not)(nil),           // 20: zoekt.webserver.v1.Not
+	(*Branch)(nil),        // 21: zoekt.webserver.v1.Branch
+	nil,                   // 22: zoekt.webserver.v1.RepoSet.SetEntry
 }
 var file_zoekt_webserver_v1_query_proto_depIdxs = []int32{
 	3,  // 0: zoekt.webserver.v1.Q.raw_config:type_name -> zoekt.webserver.v1.RawConfig
 	4,  // 1: zoekt.webserver.v1.Q.regexp:type_name -> zoekt.webserver.v1.Regexp
 	5,  // 2: zoekt.webserver.v1.Q.symbol:type_name -> zoekt.webserver.v1.Symbol
-	6,  // 3: zoekt.webserver.v1.Q.language:type_name -> zoekt.webserver.v1.Language
-	7,  // 4: zoekt.webserver.v1.Q.repo:type_name -> zoekt.webserver.v1.Repo
-	8,  // 5: zoekt.webserver.v1.Q.repo_regexp:type_name -> zoekt.webserver.v1.RepoRegexp
-	9,  // 6: zoekt.webserver.v1.Q.branches_repos:type_name -> zoekt.webserver.v1.BranchesRepos
-	11, // 7: zoekt.webserver.v1.Q.repo_ids:type_name -> zoekt.webserver.v1.RepoIds
-	12, // 8: zoekt.webserver.v1.Q.repo_set:type_name -> zoekt.webserver.v1.RepoSet
-	13, // 9: zoekt.webserver.v1.Q.file_name_set:type_name -> zoekt.webserver.v1.FileNameSet
-	14, // 10: zoekt.webserver.v1.Q.type:type_name -> zoekt.webserver.v1.Type
-	15, // 11: zoekt.webserver.v1.Q.substring:type_name -> zoekt.webserver.v1.Substring
-	16, // 12: zoekt.webserver.v1.Q.and:type_name -> zoekt.webserver.v1.And
-	17, // 13: zoekt.webserver.v1.Q.or:type_name -> zoekt.webserver.v1.Or
-	18, // 14: zoekt.webserver.v1.Q.not:type_name -> zoekt.webserver.v1.Not
-	19, // 15: zoekt.webserver.v1.Q.branch:type_name -> zoekt.webserver.v1.Branch
-	0,  // 16: zoekt.webserver.v1.RawConfig.flags:type_name -> zoekt.webserver.v1.RawConfig.Flag
-	2,  // 17: zoekt.webserver.v1.Symbol.expr:type_name -> zoekt.webserver.v1.Q
-	10, // 18: zoekt.webserver.v1.BranchesRepos.list:type_name -> zoekt.webserver.v1.BranchRepos
-	20, // 19: zoekt.webserver.v1.RepoSet.set:type_name -> zoekt.webserver.v1.RepoSet.SetEntry
-	2,  // 20: zoekt.webserver.v1.Type.child:type_name -> zoekt.webserver.v1.Q
-	1,  // 21: zoekt.webserver.v1.Type.type:type_name -> zoekt.webserver.v1.Type.Kind
-	2,  // 22: zoekt.webserver.v1.And.children:type_name -> zoekt.webserver.v1.Q
-	2,  // 23: zoekt.webserver.v1.Or.children:type_name -> zoekt.webserver.v1.Q
-	2,  // 24: zoekt.webserver.v1.Not.child:type_name -> zoekt.webserver.v1.Q
-	25, // [25:25] is the sub-list for method output_type
-	25, // [25:25] is the sub-list for method input_type
-	25, // [25:25] is the sub-list for extension type_name
-	25, // [25:25] is the sub-list for extension extendee
-	0,  // [0:25] is the sub-list for field type_name
+	7,  // 3: zoekt.webserver.v1.Q.language:type_name -> zoekt.webserver.v1.Language
+	9,  // 4: zoekt.webserver.v1.Q.repo:type_name -> zoekt.webserver.v1.Repo
+	10, // 5: zoekt.webserver.v1.Q.repo_regexp:type_name -> zoekt.webserver.v1.RepoRegexp
+	11, // 6: zoekt.webserver.v1.Q.branches_repos:type_name -> zoekt.webserver.v1.BranchesRepos
+	13, // 7: zoekt.webserver.v1.Q.repo_ids:type_name -> zoekt.webserver.v1.RepoIds
+	14, // 8: zoekt.webserver.v1.Q.repo_set:type_name -> zoekt.webserver.v1.RepoSet
+	15, // 9: zoekt.webserver.v1.Q.file_name_set:type_name -> zoekt.webserver.v1.FileNameSet
+	16, // 10: zoekt.webserver.v1.Q.type:type_name -> zoekt.webserver.v1.Type
+	17, // 11: zoekt.webserver.v1.Q.substring:type_name -> zoekt.webserver.v1.Substring
+	18, // 12: zoekt.webserver.v1.Q.and:type_name -> zoekt.webserver.v1.And
+	19, // 13: zoekt.webserver.v1.Q.or:type_name -> zoekt.webserver.v1.Or
+	20, // 14: zoekt.webserver.v1.Q.not:type_name -> zoekt.webserver.v1.Not
+	21, // 15: zoekt.webserver.v1.Q.branch:type_name -> zoekt.webserver.v1.Branch
+	6,  // 16: zoekt.webserver.v1.Q.reference:type_name -> zoekt.webserver.v1.Reference
+	8,  // 17: zoekt.webserver.v1.Q.author:type_name -> zoekt.webserver.v1.Author
+	0,  // 18: zoekt.webserver.v1.RawConfig.flags:type_name -> zoekt.webserver.v1.RawConfig.Flag
+	2,  // 19: zoekt.webserver.v1.Symbol.expr:type_name -> zoekt.webserver.v1.Q
+	2,  // 20: zoekt.webserver.v1.Reference.expr:type_name -> zoekt.webserver.v1.Q
+	12, // 21: zoekt.webserver.v1.BranchesRepos.list:type_name -> zoekt.webserver.v1.BranchRepos
+	22, // 22: zoekt.webserver.v1.RepoSet.set:type_name -> zoekt.webserver.v1.RepoSet.SetEntry
+	2,  // 23: zoekt.webserver.v1.Type.child:type_name -> zoekt.webserver.v1.Q
+	1,  // 24: zoekt.webserver.v1.Type.type:type_name -> zoekt.webserver.v1.Type.Kind
+	2,  // 25: zoekt.webserver.v1.And.children:type_name -> zoekt.webserver.v1.Q
+	2,  // 26: zoekt.webserver.v1.Or.children:type_name -> zoekt.webserver.v1.Q
+	2,  // 27: zoekt.webserver.v1.Not.child:type_name -> zoekt.webserver.v1.Q
+	28, // [28:28] is the sub-list for method output_type
+	28, // [28:28] is the sub-list for method input_type
+	28, // [28:28] is the sub-list for extension type_name
+	28, // [28:28] is the sub-list for extension extendee
+	0,  // [0:28] is the sub-list for field type_name
 }
 
 func init() { file_zoekt_webserver_v1_query_proto_init() }
@@ -1600,7 +1747,7 @@ func file_zoekt_webserver_v1_query_proto_init() {
 			}
 		}
 		file_zoekt_webserver_v1_query_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Language); i {
+			switch v := v.(*Reference); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1612,7 +1759,7 @@ func file_zoekt_webserver_v1_query_proto_init() {
 			}
 		}
 		file_zoekt_webserver_v1_query_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Repo); i {
+			switch v := v.(*Language); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1624,7 +1771,7 @@ func file_zoekt_webserver_v1_query_proto_init() {
 			}
 		}
 		file_zoekt_webserver_v1_query_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RepoRegexp); i {
+			switch v := v.(*Author); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1636,7 +1783,7 @@ func file_zoekt_webserver_v1_query_proto_init() {
 			}
 		}
 		file_zoekt_webserver_v1_query_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BranchesRepos); i {
+			switch v := v.(*Repo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1648,7 +1795,7 @@ func file_zoekt_webserver_v1_query_proto_init() {
 			}
 		}
 		file_zoekt_webserver_v1_query_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BranchRepos); i {
+			switch v := v.(*RepoRegexp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1660,7 +1807,7 @@ func file_zoekt_webserver_v1_query_proto_init() {
 			}
 		}
 		file_zoekt_webserver_v1_query_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RepoIds); i {
+			switch v := v.(*BranchesRepos); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1672,7 +1819,7 @@ func file_zoekt_webserver_v1_query_proto_init() {
 			}
 		}
 		file_zoekt_webserver_v1_query_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RepoSet); i {
+			switch v := v.(*BranchRepos); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1684,7 +1831,7 @@ func file_zoekt_webserver_v1_query_proto_init() {
 			}
 		}
 		file_zoekt_webserver_v1_query_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FileNameSet); i {
+			switch v := v.(*RepoIds); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1696,7 +1843,7 @@ func file_zoekt_webserver_v1_query_proto_init() {
 			}
 		}
 		file_zoekt_webserver_v1_query_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Type); i {
+			switch v := v.(*RepoSet); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1708,7 +1855,7 @@ func file_zoekt_webserver_v1_query_proto_init() {
 			}
 		}
 		file_zoekt_webserver_v1_query_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Substring); i {
+			switch v := v.(*FileNameSet); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1720,7 +1867,7 @@ func file_zoekt_webserver_v1_query_proto_init() {
 			}
 		}
 		file_zoekt_webserver_v1_query_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*And); i {
+			switch v := v.(*Type); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1732,7 +1879,7 @@ func file_zoekt_webserver_v1_query_proto_init() {
 			}
 		}
 		file_zoekt_webserver_v1_query_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Or); i {
+			switch v := v.(*Substring); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1744,7 +1891,7 @@ func file_zoekt_webserver_v1_query_proto_init() {
 			}
 		}
 		file_zoekt_webserver_v1_query_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Not); i {
+			switch v := v.(*And); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1756,6 +1903,30 @@ func file_zoekt_webserver_v1_query_proto_init() {
 			}
 		}
 		file_zoekt_webserver_v1_query_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Or); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_zoekt_webserver_v1_query_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Not); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_zoekt_webserver_v1_query_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Branch); i {
 			case 0:
 				return &v.state
@@ -1786,6 +1957,8 @@ func file_zoekt_webserver_v1_query_proto_init() {
 		(*Q_Or)(nil),
 		(*Q_Not)(nil),
 		(*Q_Branch)(nil),
+		(*Q_Reference)(nil),
+		(*Q_Author)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1793,7 +1966,7 @@ func file_zoekt_webserver_v1_query_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_zoekt_webserver_v1_query_proto_rawDesc,
 			NumEnums:      2,
-			NumMessages:   19,
+			NumMessages:   21,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
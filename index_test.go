@@ -23,6 +23,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/RoaringBitmap/roaring"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/grafana/regexp"
@@ -1090,22 +1091,161 @@ func TestBranchMask(t *testing.T) {
 	})
 }
 
-func TestBranchLimit(t *testing.T) {
-	for limit := 64; limit <= 65; limit++ {
-		r := &Repository{}
-		for i := 0; i < limit; i++ {
-			s := fmt.Sprintf("b%d", i)
-			r.Branches = append(r.Branches, RepositoryBranch{
-				s, "v-" + s,
-			})
+func TestManyBranches(t *testing.T) {
+	r := &Repository{ID: 1, Name: "repo"}
+	for i := 0; i < 130; i++ {
+		s := fmt.Sprintf("b%d", i)
+		r.Branches = append(r.Branches, RepositoryBranch{
+			s, "v-" + s,
+		})
+	}
+	b := testIndexBuilder(t, r,
+		Document{Name: "f0", Content: []byte("needle"), Branches: []string{"b0"}},
+		Document{Name: "f1", Content: []byte("needle"), Branches: []string{"b1", "b100"}},
+		Document{Name: "f2", Content: []byte("needle"), Branches: []string{"b0", "b129"}})
+
+	if !b.hasBranchMasksHigh {
+		t.Fatal("expected branchMasksHigh section")
+	}
+
+	check := func(t *testing.T, b *IndexBuilder) {
+		for _, tc := range []struct {
+			q    query.Q
+			want map[string][]string
+		}{
+			{&query.Substring{Pattern: "needle"}, map[string][]string{
+				"f0": {"b0"},
+				"f1": {"b1", "b100"},
+				"f2": {"b0", "b129"},
+			}},
+			{&query.Branch{Pattern: "b100", Exact: true}, map[string][]string{
+				"f1": {"b100"},
+			}},
+			{&query.Branch{Pattern: "b12"}, map[string][]string{
+				"f2": {"b129"},
+			}},
+			{&query.Branch{Pattern: "HEAD"}, map[string][]string{
+				"f0": {"b0"},
+				"f2": {"b0"},
+			}},
+			{&query.BranchesRepos{List: []query.BranchRepos{
+				{Branch: "b129", Repos: roaring.BitmapOf(r.ID)},
+			}}, map[string][]string{
+				"f2": {"b0", "b129"},
+			}},
+		} {
+			sres := searchForTest(t, b, tc.q)
+			got := map[string][]string{}
+			for _, f := range sres.Files {
+				got[f.FileName] = f.Branches
+			}
+			if d := cmp.Diff(tc.want, got); d != "" {
+				t.Errorf("%s: mismatch (-want +got):\n%s", tc.q, d)
+			}
 		}
-		_, err := NewIndexBuilder(r)
-		if limit == 64 && err != nil {
-			t.Fatalf("NewIndexBuilder: %v", err)
-		} else if limit == 65 && err == nil {
-			t.Fatalf("NewIndexBuilder succeeded")
+	}
+
+	check(t, b)
+
+	// Readers which don't know the section must refuse the shard.
+	if v := searcherForTest(t, b).(*indexData).metaData.IndexMinReaderVersion; v != branchMasksHighFeatureVersion {
+		t.Errorf("got min reader version %d, want %d", v, branchMasksHighFeatureVersion)
+	}
+
+	t.Run("Merge", func(t *testing.T) {
+		d := searcherForTest(t, b).(*indexData)
+		merged, err := merge(d)
+		if err != nil {
+			t.Fatal(err)
+		}
+		check(t, merged)
+	})
+}
+
+func TestCommitDocuments(t *testing.T) {
+	b := testIndexBuilder(t, nil,
+		Document{Name: "f0", Content: []byte("needle")},
+		Document{Name: "0123abcd", Content: []byte("fix needle"), Commit: true})
+
+	d := searcherForTest(t, b).(*indexData)
+	// Readers which don't know the section would return commits as files.
+	if v := d.metaData.IndexMinReaderVersion; v != fileKindsFeatureVersion {
+		t.Errorf("got min reader version %d, want %d", v, fileKindsFeatureVersion)
+	}
+
+	for _, tc := range []struct {
+		q    query.Q
+		want []string
+	}{
+		{&query.Substring{Pattern: "needle"}, []string{"f0"}},
+		{&query.Type{Type: query.TypeCommit, Child: &query.Substring{Pattern: "needle"}}, []string{"0123abcd"}},
+	} {
+		var got []string
+		for _, f := range searchForTest(t, b, tc.q).Files {
+			got = append(got, f.FileName)
+		}
+		if d := cmp.Diff(tc.want, got); d != "" {
+			t.Errorf("%s: mismatch (-want +got):\n%s", tc.q, d)
+		}
+	}
+}
+
+func TestAuthors(t *testing.T) {
+	b := testIndexBuilder(t, nil,
+		Document{Name: "f0", Content: []byte("needle"), Author: "Alice <alice@example.com>"},
+		Document{Name: "f1", Content: []byte("needle")},
+		Document{Name: "f2", Content: []byte("needle"), Author: "Bob <bob@example.com>"},
+		Document{Name: "f3", Content: []byte("needle"), Author: "Alice <alice@example.com>"})
+
+	check := func(t *testing.T, b *IndexBuilder) {
+		for _, tc := range []struct {
+			q    query.Q
+			want map[string]string
+		}{
+			{&query.Substring{Pattern: "needle"}, map[string]string{
+				"f0": "Alice <alice@example.com>",
+				"f1": "",
+				"f2": "Bob <bob@example.com>",
+				"f3": "Alice <alice@example.com>",
+			}},
+			{&query.Author{Regexp: regexp.MustCompile("alice@")}, map[string]string{
+				"f0": "Alice <alice@example.com>",
+				"f3": "Alice <alice@example.com>",
+			}},
+			{&query.Not{Child: &query.Author{Regexp: regexp.MustCompile("Alice")}}, map[string]string{
+				"f1": "",
+				"f2": "Bob <bob@example.com>",
+			}},
+		} {
+			sres := searchForTest(t, b, tc.q)
+			got := map[string]string{}
+			for _, f := range sres.Files {
+				got[f.FileName] = f.Author
+			}
+			if d := cmp.Diff(tc.want, got); d != "" {
+				t.Errorf("%s: mismatch (-want +got):\n%s", tc.q, d)
+			}
 		}
 	}
+
+	check(t, b)
+
+	t.Run("Merge", func(t *testing.T) {
+		d := searcherForTest(t, b).(*indexData)
+		merged, err := merge(d)
+		if err != nil {
+			t.Fatal(err)
+		}
+		check(t, merged)
+	})
+
+	t.Run("NoAuthors", func(t *testing.T) {
+		b := testIndexBuilder(t, nil, Document{Name: "f0", Content: []byte("needle")})
+		sres := searchForTest(t, b, &query.Author{Regexp: regexp.MustCompile(".")})
+		if len(sres.Files) != 0 {
+			t.Errorf("got %v, want no results", sres.Files)
+		}
+	})
 }
 
 func TestBranchReport(t *testing.T) {
@@ -3684,3 +3824,86 @@ func TestWordSearch(t *testing.T) {
 		}
 	})
 }
+
+func TestReference(t *testing.T) {
+	content := []byte("func parse() {}\n// parse here\nparse()\nreparse()")
+	// ----------------012345678901234 5678901234567 8901234 567890123
+
+	b := testIndexBuilder(t, &Repository{Name: "reponame"},
+		Document{
+			Name:       "f1",
+			Content:    content,
+			Symbols:    []DocumentSection{{5, 10}},
+			References: []DocumentSection{{30, 35}, {38, 45}},
+		},
+	)
+
+	for _, q := range []query.Q{
+		&query.Reference{Expr: &query.Substring{Pattern: "parse"}},
+		&query.Reference{Expr: &query.Regexp{Regexp: mustParseRE("pars[e]")}},
+	} {
+		t.Run(q.String(), func(t *testing.T) {
+			res := searchForTest(t, b, q)
+			if len(res.Files) != 1 {
+				t.Fatalf("got %v, want 1 file", res.Files)
+			}
+			var got []uint32
+			for _, l := range res.Files[0].LineMatches {
+				for _, f := range l.LineFragments {
+					got = append(got, f.Offset)
+				}
+			}
+			if want := []uint32{30, 40}; !reflect.DeepEqual(got, want) {
+				t.Errorf("got offsets %v, want %v", got, want)
+			}
+
+			res = searchForTest(t, b, q, chunkOpts)
+			got = got[:0]
+			for _, c := range res.Files[0].ChunkMatches {
+				for _, r := range c.Ranges {
+					got = append(got, r.Start.ByteOffset)
+				}
+			}
+			if want := []uint32{30, 40}; !reflect.DeepEqual(got, want) {
+				t.Errorf("got chunk offsets %v, want %v", got, want)
+			}
+		})
+	}
+
+	t.Run("no references", func(t *testing.T) {
+		q := &query.Reference{Expr: &query.Substring{Pattern: "here"}}
+		if res := searchForTest(t, b, q); len(res.Files) != 0 {
+			t.Errorf("got %v, want no matches", res.Files)
+		}
+	})
+}
+
+func TestReferenceScore(t *testing.T) {
+	content := []byte("// parse\nparse()\n")
+	// ----------------012345678 901234
+
+	b := testIndexBuilder(t, &Repository{Name: "reponame"},
+		Document{
+			Name:       "f1",
+			Content:    content,
+			References: []DocumentSection{{9, 14}},
+		},
+	)
+
+	searcher := searcherForTest(t, b)
+	res, err := searcher.Search(context.Background(), &query.Substring{Pattern: "parse", Content: true}, &SearchOptions{ChunkMatches: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Files) != 1 || len(res.Files[0].ChunkMatches) != 2 {
+		t.Fatalf("got %v, want 2 chunks in 1 file", res.Files)
+	}
+	// Chunks are ordered by score.
+	ref, comment := res.Files[0].ChunkMatches[0], res.Files[0].ChunkMatches[1]
+	if ref.ContentStart.LineNumber != 2 {
+		t.Fatalf("got first chunk on line %d, want the reference on line 2", ref.ContentStart.LineNumber)
+	}
+	if ref.Score-comment.Score < scoreReference-scoreLineOrderFactor {
+		t.Errorf("reference score %f should be above comment score %f by about %f", ref.Score, comment.Score, scoreReference)
+	}
+}
@@ -171,6 +171,21 @@ type IndexBuilder struct {
 	nameStrings     []*searchableString
 	docSections     [][]DocumentSection
 	runeDocSections []DocumentSection
+	docReferences   [][]DocumentSection
+	hasReferences   bool
+	docEncodings    []string
+	hasEncodings    bool
+
+	// docKinds holds a docKind for each document.
+	docKinds   []byte
+	hasCommits bool
+
+	// docAuthors holds the authorID of each document, see authorID.
+	docAuthors  []uint32
+	authorIndex map[string]uint32
+	authorNames []string
+
+	secretFindings []SecretFinding
 
 	symID        uint32
 	symIndex     map[string]uint32
@@ -183,7 +198,13 @@ type IndexBuilder struct {
 	checksums []byte
 
 	branchMasks []uint64
-	subRepos    []uint32
+
+	// branchMasksHigh holds the encoded words of the branchSet of each
+	// document after the first one, see branchSet.marshalHigh.
+	branchMasksHigh    [][]byte
+	hasBranchMasksHigh bool
+
+	subRepos []uint32
 
 	// docID => repoID
 	repos []uint16
@@ -255,6 +276,7 @@ func newIndexBuilder() *IndexBuilder {
 		symIndex:        make(map[string]uint32),
 		symKindIndex:    make(map[string]uint32),
 		languageMap:     make(map[string]uint16),
+		authorIndex:     make(map[string]uint32),
 	}
 }
 
@@ -263,10 +285,6 @@ func (b *IndexBuilder) setRepository(desc *Repository) error {
 		return err
 	}
 
-	if len(desc.Branches) > 64 {
-		return fmt.Errorf("too many branches")
-	}
-
 	repo := *desc
 
 	// copy subrepomap without root
@@ -302,6 +320,38 @@ type Document struct {
 	Symbols         []DocumentSection
 	SymbolsMetaData []*Symbol
 
+	// References are the document sections of identifiers which refer to a
+	// symbol, e.g. calls, as opposed to its definition. Offsets should use
+	// bytes.
+	References []DocumentSection
+
+	// Generated, Vendored and Documentation override the guesses of the
+	// builder, which ranks such documents lower. Nil means no override. They
+	// are typically set from linguist attributes in .gitattributes.
+	Generated     *bool
+	Vendored      *bool
+	Documentation *bool
+
+	// Encoding is the encoding of the original file, e.g. "Shift_JIS", if
+	// Content was transcoded to UTF-8. It is empty for UTF-8 files. Offsets,
+	// e.g. of Symbols, refer to the transcoded Content.
+	Encoding string
+
+	// Commit marks a virtual document describing a commit rather than a
+	// file. Name is the commit hash and Content its message, author, date
+	// and changed paths. Commit documents are only returned for type:commit
+	// queries, see query.TypeCommit.
+	Commit bool
+
+	// Author is the author of the last commit touching the file, formatted
+	// as "Name <email>". It is empty if unknown.
+	Author string
+
+	// Size is the size of the file in bytes if it is added without Content,
+	// e.g. because it exceeds the maximum size. It is only reported in dry
+	// runs.
+	Size int64
+
 	// Ranks is a vector of ranks for a document as provided by a DocumentRanksFile
 	// file in the git repo.
 	//
@@ -421,6 +471,21 @@ func (b *IndexBuilder) symbolKindID(t string) uint32 {
 	return b.symKindIndex[t]
 }
 
+// authorID returns the ID of author, which is its index in authorNames
+// plus one. The empty author has ID 0.
+func (b *IndexBuilder) authorID(author string) uint32 {
+	if author == "" {
+		return 0
+	}
+	id, ok := b.authorIndex[author]
+	if !ok {
+		b.authorNames = append(b.authorNames, author)
+		id = uint32(len(b.authorNames))
+		b.authorIndex[author] = id
+	}
+	return id
+}
+
 func (b *IndexBuilder) addSymbols(symbols []*Symbol) {
 	for _, sym := range symbols {
 		b.symMetaData = append(b.symMetaData,
@@ -459,6 +524,8 @@ func (b *IndexBuilder) Add(doc Document) error {
 		doc.Content = []byte(notIndexedMarker + doc.SkipReason)
 		doc.Symbols = nil
 		doc.SymbolsMetaData = nil
+		doc.References = nil
+		doc.Encoding = ""
 		if doc.Language == "" {
 			doc.Language = "skipped"
 		}
@@ -480,6 +547,20 @@ func (b *IndexBuilder) Add(doc Document) error {
 		return fmt.Errorf("section goes past end of content")
 	}
 
+	sort.Slice(doc.References, func(i, j int) bool {
+		return doc.References[i].Start < doc.References[j].Start
+	})
+	last = DocumentSection{}
+	for i, s := range doc.References {
+		if i > 0 && last.End > s.Start {
+			return fmt.Errorf("reference sections overlap")
+		}
+		last = s
+	}
+	if last.End > uint32(len(doc.Content)) {
+		return fmt.Errorf("reference section goes past end of content")
+	}
+
 	if doc.SubRepositoryPath != "" {
 		rel, err := filepath.Rel(doc.SubRepositoryPath, doc.Name)
 		if err != nil || rel == doc.Name {
@@ -502,13 +583,16 @@ func (b *IndexBuilder) Add(doc Document) error {
 		return fmt.Errorf("unknown subrepo path %q", doc.SubRepositoryPath)
 	}
 
-	var mask uint64
+	var branches branchSet
 	for _, br := range doc.Branches {
-		m := b.branchMask(br)
-		if m == 0 {
+		i := b.branchIndex(br)
+		if i < 0 {
 			return fmt.Errorf("no branch found for %s", br)
 		}
-		mask |= m
+		branches.add(i)
+	}
+	if len(branches) == 0 {
+		branches = branchSet{0}
 	}
 
 	if repoIdx > 1<<16 {
@@ -529,8 +613,21 @@ func (b *IndexBuilder) Add(doc Document) error {
 
 	b.nameStrings = append(b.nameStrings, nameStr)
 	b.docSections = append(b.docSections, doc.Symbols)
+	b.docReferences = append(b.docReferences, doc.References)
+	b.hasReferences = b.hasReferences || len(doc.References) > 0
+	b.docEncodings = append(b.docEncodings, doc.Encoding)
+	b.hasEncodings = b.hasEncodings || doc.Encoding != ""
+	if doc.Commit {
+		b.docKinds = append(b.docKinds, docKindCommit)
+		b.hasCommits = true
+	} else {
+		b.docKinds = append(b.docKinds, docKindFile)
+	}
+	b.docAuthors = append(b.docAuthors, b.authorID(doc.Author))
 	b.fileEndSymbol = append(b.fileEndSymbol, uint32(len(b.runeDocSections)))
-	b.branchMasks = append(b.branchMasks, mask)
+	b.branchMasks = append(b.branchMasks, branches[0])
+	b.branchMasksHigh = append(b.branchMasksHigh, branches.marshalHigh())
+	b.hasBranchMasksHigh = b.hasBranchMasksHigh || len(branches) > 1
 	b.checksums = append(b.checksums, hasher.Sum(nil)...)
 
 	langCode, ok := b.languageMap[doc.Language]
@@ -546,11 +643,13 @@ func (b *IndexBuilder) Add(doc Document) error {
 	return nil
 }
 
-func (b *IndexBuilder) branchMask(br string) uint64 {
+// branchIndex returns the index of branch br in the current repository, or
+// -1 if it is unknown.
+func (b *IndexBuilder) branchIndex(br string) int {
 	for i, b := range b.repoList[len(b.repoList)-1].Branches {
 		if b.Name == br {
-			return uint64(1) << uint(i)
+			return i
 		}
 	}
-	return 0
+	return -1
 }
@@ -45,6 +45,16 @@ type indexData struct {
 
 	runeDocSections []DocumentSection
 
+	// referencesIndex is like docSectionsIndex, but for the reference
+	// sections of documents. It is empty for shards without references.
+	referencesStart uint32
+	referencesIndex []uint32
+
+	// encodingsIndex indexes the original encodings of documents, see
+	// Document.Encoding. It is empty if no document was transcoded.
+	encodingsStart uint32
+	encodingsIndex []uint32
+
 	// rune offset=>byte offset mapping, relative to the start of the content corpus
 	runeOffsets runeOffsetMap
 
@@ -68,13 +78,29 @@ type indexData struct {
 	// rune offsets for the file name boundaries
 	fileNameEndRunes []uint32
 
-	fileBranchMasks []uint64
+	// fileBranchMasks holds the first word of the branchSet of each
+	// document, and fileBranchMasksHigh the remaining words. The latter is
+	// nil unless a repository has more than 64 branches.
+	fileBranchMasks     []uint64
+	fileBranchMasksHigh [][]uint64
+
+	// fileKinds holds the docKind of each document. It is nil if the shard
+	// has no commit documents.
+	fileKinds []byte
+
+	// fileAuthors holds the author ID of each document, see authorNames. It
+	// is nil if no author was recorded.
+	fileAuthors []uint32
+
+	// authorNames holds the distinct authors of the shard. Author ID i > 0
+	// is authorNames[i-1].
+	authorNames []string
 
-	// mask (power of 2) => name
-	branchNames []map[uint]string
+	// branch index => name
+	branchNames [][]string
 
-	// name => mask (power of 2)
-	branchIDs []map[string]uint
+	// name => branch index
+	branchIDs []map[string]int
 
 	metaData     IndexMetadata
 	repoMetaData []Repository
@@ -99,6 +125,9 @@ type indexData struct {
 	// Experimental: docID => rank vec
 	ranks [][]float64
 
+	// secretFindings are the secrets found while indexing the shard.
+	secretFindings []SecretFinding
+
 	// rawConfigMasks contains the encoded RawConfig for each repository
 	rawConfigMasks []uint8
 }
@@ -260,6 +289,9 @@ func (d *indexData) calculateNewLinesStats(start, end uint32) (count, defaultCou
 		branchMask := d.fileBranchMasks[i]
 		isDefault := (branchMask & 1) == 1
 		others := uint64(bits.OnesCount64(branchMask >> 1))
+		if d.fileBranchMasksHigh != nil {
+			others += uint64(branchSet(d.fileBranchMasksHigh[i]).count())
+		}
 
 		// this is readNewlines but only reading the size of each section which
 		// corresponds to the number of newlines.
@@ -297,17 +329,21 @@ func (d *indexData) String() string {
 func (d *indexData) memoryUse() int {
 	sz := 0
 	for _, a := range [][]uint32{
-		d.newlinesIndex, d.docSectionsIndex,
+		d.newlinesIndex, d.docSectionsIndex, d.referencesIndex, d.encodingsIndex,
 		d.boundaries, d.fileNameIndex,
 		d.fileEndRunes, d.fileNameEndRunes,
 		d.fileEndSymbol, d.symbols.symKindIndex,
-		d.subRepos,
+		d.subRepos, d.fileAuthors,
 	} {
 		sz += 4 * len(a)
 	}
 	sz += d.runeOffsets.sizeBytes()
 	sz += d.fileNameRuneOffsets.sizeBytes()
 	sz += len(d.languages)
+	sz += len(d.fileKinds)
+	for _, a := range d.authorNames {
+		sz += len(a)
+	}
 	sz += len(d.checksums)
 	sz += 2 * len(d.repos)
 	if len(d.ranks) > 0 {
@@ -315,6 +351,9 @@ func (d *indexData) memoryUse() int {
 	}
 	sz += 8 * len(d.runeDocSections)
 	sz += 8 * len(d.fileBranchMasks)
+	for _, h := range d.fileBranchMasksHigh {
+		sz += 8 * len(h)
+	}
 	sz += d.contentNgrams.SizeBytes()
 	sz += d.fileNameNgrams.SizeBytes()
 	return sz
@@ -0,0 +1,92 @@
+// Package clientid carries the identity of the client which issued a search
+// through the context of the search. The identity is used by the shards
+// scheduler to share capacity fairly between clients.
+//
+// Clients identify themselves with the X-Zoekt-Client HTTP header or the
+// equivalent gRPC metadata key.
+package clientid
+
+import (
+	"context"
+	"net/http"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// Header is the HTTP header identifying the client.
+const Header = "X-Zoekt-Client"
+
+// metadataKey is the gRPC metadata key identifying the client. gRPC metadata
+// keys are lowercase.
+const metadataKey = "x-zoekt-client"
+
+// maxLen bounds the length of client identities we accept.
+const maxLen = 64
+
+type contextKey struct{}
+
+// WithClient returns a context which identifies the client as id.
+func WithClient(ctx context.Context, id string) context.Context {
+	if id == "" {
+		return ctx
+	}
+	if len(id) > maxLen {
+		id = id[:maxLen]
+	}
+	return context.WithValue(ctx, contextKey{}, id)
+}
+
+// FromContext returns the client identity of ctx, or "" if it is unknown.
+func FromContext(ctx context.Context) string {
+	id, _ := ctx.Value(contextKey{}).(string)
+	return id
+}
+
+// Middleware sets the client identity of requests from the X-Zoekt-Client
+// header.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if id := r.Header.Get(Header); id != "" {
+			r = r.WithContext(WithClient(r.Context(), id))
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// OutgoingContext adds the client identity of ctx to the outgoing gRPC
+// metadata, so it is passed on to the server.
+func OutgoingContext(ctx context.Context) context.Context {
+	if id := FromContext(ctx); id != "" {
+		return metadata.AppendToOutgoingContext(ctx, metadataKey, id)
+	}
+	return ctx
+}
+
+func incomingContext(ctx context.Context) context.Context {
+	if v := metadata.ValueFromIncomingContext(ctx, metadataKey); len(v) > 0 {
+		return WithClient(ctx, v[0])
+	}
+	return ctx
+}
+
+// UnaryServerInterceptor sets the client identity of requests from the gRPC
+// metadata.
+func UnaryServerInterceptor(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	return handler(incomingContext(ctx), req)
+}
+
+// StreamServerInterceptor sets the client identity of streams from the gRPC
+// metadata.
+func StreamServerInterceptor(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return handler(srv, &serverStream{ServerStream: ss, ctx: incomingContext(ss.Context())})
+}
+
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
@@ -14,11 +14,18 @@ import (
 // take. This is the same default used by Sourcegraph.
 const defaultTimeout = 20 * time.Second
 
+// JSONServer returns a http.Handler serving the JSON API of searcher. If
+// searcher is a zoekt.Streamer it also serves /stream, which streams results
+// as Server-Sent Events.
 func JSONServer(searcher zoekt.Searcher) http.Handler {
 	s := jsonSearcher{searcher}
 	mux := http.NewServeMux()
 	mux.HandleFunc("/search", s.jsonSearch)
 	mux.HandleFunc("/list", s.jsonList)
+	if streamer, ok := searcher.(zoekt.Streamer); ok {
+		sse := sseSearcher{streamer}
+		mux.HandleFunc("/stream", sse.serveStream)
+	}
 	return mux
 }
 
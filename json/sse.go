@@ -0,0 +1,183 @@
+package json
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/sourcegraph/zoekt"
+	"github.com/sourcegraph/zoekt/query"
+	"github.com/sourcegraph/zoekt/stream"
+)
+
+// The events of the /stream endpoint, see sseSearcher.
+const (
+	sseEventMatches  = "matches"
+	sseEventProgress = "progress"
+	sseEventStats    = "stats"
+	sseEventDone     = "done"
+	sseEventError    = "error"
+)
+
+// sseSearcher streams search results as Server-Sent Events, so browsers can
+// consume them with EventSource:
+//
+//	GET /stream?q=<query>&opts=<JSON encoded zoekt.SearchOptions>
+//
+// The response is a text/event-stream with these events, whose data is JSON:
+//
+//	matches:  {"Files": [...], "RepoURLs": {...}, "LineFragments": {...}}
+//	progress: the zoekt.Stats aggregated so far
+//	stats:    {"Stats": {...}, "IncompleteShards": [...], "BackendErrors": [...]}
+//	          once the search is finished
+//	error:    {"Error": "..."} if the search failed
+//	done:     {} as the last event
+//
+// EventSource reconnects once a stream ends, so clients should close it when
+// they receive done.
+type sseSearcher struct {
+	Streamer zoekt.Streamer
+}
+
+type sseMatches struct {
+	Files         []zoekt.FileMatch
+	RepoURLs      map[string]string `json:",omitempty"`
+	LineFragments map[string]string `json:",omitempty"`
+}
+
+type sseStats struct {
+	Stats            zoekt.Stats
+	IncompleteShards []zoekt.IncompleteShard `json:",omitempty"`
+	BackendErrors    []zoekt.BackendError    `json:",omitempty"`
+}
+
+func (s *sseSearcher) serveStream(w http.ResponseWriter, req *http.Request) {
+	if req.Method != "GET" {
+		jsonError(w, http.StatusMethodNotAllowed, "Only GET is supported")
+		return
+	}
+
+	ew, err := newSSEWriter(w)
+	if err != nil {
+		jsonError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// The error event is more useful to EventSource clients than a HTTP
+	// status code, so all errors are reported as events.
+	defer ew.event(sseEventDone, struct{}{})
+
+	final, err := s.search(req, ew)
+	if err != nil {
+		ew.event(sseEventError, struct{ Error string }{Error: err.Error()})
+		return
+	}
+	ew.event(sseEventStats, final)
+}
+
+// search runs the search of req and streams its matches and progress to ew.
+// It returns the final stats.
+func (s *sseSearcher) search(req *http.Request, ew *sseWriter) (*sseStats, error) {
+	ctx := req.Context()
+
+	queryStr := req.URL.Query().Get("q")
+	if queryStr == "" {
+		return nil, errors.New("missing query")
+	}
+	q, err := query.Parse(queryStr)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := &zoekt.SearchOptions{}
+	if o := req.URL.Query().Get("opts"); o != "" {
+		if err := json.Unmarshal([]byte(o), opts); err != nil {
+			return nil, fmt.Errorf("invalid opts: %w", err)
+		}
+	}
+
+	// Set a timeout if the user hasn't specified one.
+	if opts.MaxWallTime == 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, defaultTimeout)
+		defer cancel()
+	}
+
+	if err := CalculateDefaultSearchLimits(ctx, q, s.Streamer, opts); err != nil {
+		return nil, err
+	}
+
+	var (
+		mu    sync.Mutex
+		final sseStats
+	)
+	send := func(sr *zoekt.SearchResult) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		final.Stats.Add(sr.Stats)
+		final.IncompleteShards = append(final.IncompleteShards, sr.IncompleteShards...)
+		final.BackendErrors = append(final.BackendErrors, sr.BackendErrors...)
+
+		if len(sr.Files) > 0 {
+			ew.event(sseEventMatches, sseMatches{
+				Files:         sr.Files,
+				RepoURLs:      sr.RepoURLs,
+				LineFragments: sr.LineFragments,
+			})
+		}
+		ew.event(sseEventProgress, final.Stats)
+	}
+
+	sampler := stream.NewSamplingSender(stream.SenderFunc(send))
+	if err := s.Streamer.StreamSearch(ctx, q, opts, sampler); err != nil {
+		return nil, err
+	}
+	sampler.Flush()
+
+	return &final, nil
+}
+
+type sseWriter struct {
+	w     http.ResponseWriter
+	flush func()
+
+	// err is the first error writing to w. Once set we stop writing.
+	err error
+}
+
+func newSSEWriter(w http.ResponseWriter) (*sseWriter, error) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		return nil, errors.New("http flushing not supported")
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+
+	// This informs nginx to not buffer, see stream.Server.
+	w.Header().Set("X-Accel-Buffering", "no")
+
+	return &sseWriter{w: w, flush: flusher.Flush}, nil
+}
+
+// event writes an event with data encoded as JSON. JSON encodes newlines in
+// strings, so the data always fits on a single "data:" line.
+func (e *sseWriter) event(event string, data any) {
+	if e.err != nil {
+		return
+	}
+	b, err := json.Marshal(data)
+	if err != nil {
+		b, _ = json.Marshal(struct{ Error string }{Error: err.Error()})
+		event = sseEventError
+	}
+	if _, e.err = fmt.Fprintf(e.w, "event: %s\ndata: %s\n\n", event, b); e.err != nil {
+		return
+	}
+	e.flush()
+}
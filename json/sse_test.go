@@ -0,0 +1,148 @@
+package json_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/zoekt"
+	"github.com/sourcegraph/zoekt/internal/mockSearcher"
+	zjson "github.com/sourcegraph/zoekt/json"
+	"github.com/sourcegraph/zoekt/query"
+)
+
+// mockStreamer sends the result of MockSearcher.Search as one event per file.
+type mockStreamer struct {
+	mockSearcher.MockSearcher
+}
+
+func (s *mockStreamer) StreamSearch(ctx context.Context, q query.Q, opts *zoekt.SearchOptions, sender zoekt.Sender) error {
+	sr, err := s.Search(ctx, q, opts)
+	if err != nil {
+		return err
+	}
+	for _, f := range sr.Files {
+		sender.Send(&zoekt.SearchResult{
+			Stats: zoekt.Stats{FileCount: 1, MatchCount: 1},
+			Files: []zoekt.FileMatch{f},
+		})
+	}
+	return nil
+}
+
+type sseEvent struct {
+	Event string
+	Data  string
+}
+
+func readEvents(t *testing.T, resp *http.Response) []sseEvent {
+	t.Helper()
+
+	var (
+		events []sseEvent
+		ev     sseEvent
+	)
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case line == "":
+			events = append(events, ev)
+			ev = sseEvent{}
+		case strings.HasPrefix(line, "event: "):
+			ev.Event = strings.TrimPrefix(line, "event: ")
+		case strings.HasPrefix(line, "data: "):
+			ev.Data = strings.TrimPrefix(line, "data: ")
+		default:
+			t.Fatalf("unexpected line %q", line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatal(err)
+	}
+	return events
+}
+
+func TestStreamServer(t *testing.T) {
+	searchQuery := "hello"
+	mock := &mockStreamer{mockSearcher.MockSearcher{
+		WantSearch: mustParse(searchQuery),
+		SearchResult: &zoekt.SearchResult{
+			Files: []zoekt.FileMatch{
+				{FileName: "a.go"},
+				{FileName: "b.go"},
+			},
+		},
+	}}
+
+	ts := httptest.NewServer(zjson.JSONServer(mock))
+	defer ts.Close()
+
+	get := func(t *testing.T, q string) []sseEvent {
+		t.Helper()
+		resp, err := http.Get(ts.URL + "/stream?q=" + url.QueryEscape(q))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		if got := resp.Header.Get("Content-Type"); got != "text/event-stream" {
+			t.Fatalf("got Content-Type %q", got)
+		}
+		return readEvents(t, resp)
+	}
+
+	t.Run("matches", func(t *testing.T) {
+		events := get(t, searchQuery)
+
+		var names, files []string
+		for _, ev := range events {
+			names = append(names, ev.Event)
+			if ev.Event != "matches" {
+				continue
+			}
+			var m struct{ Files []zoekt.FileMatch }
+			if err := json.Unmarshal([]byte(ev.Data), &m); err != nil {
+				t.Fatal(err)
+			}
+			for _, f := range m.Files {
+				files = append(files, f.FileName)
+			}
+		}
+
+		if names[len(names)-2] != "stats" || names[len(names)-1] != "done" {
+			t.Fatalf("got events %v, want stats and done last", names)
+		}
+		if d := cmp.Diff([]string{"a.go", "b.go"}, files); d != "" {
+			t.Errorf("files mismatch (-want +got):\n%s", d)
+		}
+
+		var final struct{ Stats zoekt.Stats }
+		if err := json.Unmarshal([]byte(events[len(events)-2].Data), &final); err != nil {
+			t.Fatal(err)
+		}
+		if final.Stats.FileCount != 2 || final.Stats.MatchCount != 2 {
+			t.Errorf("got stats %+v, want 2 files and matches", final.Stats)
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		events := get(t, "bye")
+		if len(events) != 2 || events[0].Event != "error" || events[1].Event != "done" {
+			t.Fatalf("got events %v, want error and done", events)
+		}
+		var e struct{ Error string }
+		if err := json.Unmarshal([]byte(events[0].Data), &e); err != nil {
+			t.Fatal(err)
+		}
+		if e.Error == "" {
+			t.Error("expected an error message")
+		}
+	})
+}
@@ -172,17 +172,33 @@ type substrMatchTree struct {
 }
 
 type branchQueryMatchTree struct {
-	fileMasks []uint64
-	masks     []uint64
-	repos     []uint16
+	fileMasks     []uint64
+	fileMasksHigh [][]uint64
+	masks         []branchSet
+	repos         []uint16
 
 	// mutable
 	firstDone bool
 	docID     uint32
 }
 
-func (t *branchQueryMatchTree) branchMask() uint64 {
-	return t.fileMasks[t.docID] & t.masks[t.repos[t.docID]]
+// branches returns the branches of the current document which match the
+// query.
+func (t *branchQueryMatchTree) branches() branchSet {
+	set := branchSet{t.fileMasks[t.docID]}
+	if t.fileMasksHigh != nil {
+		set = append(set, t.fileMasksHigh[t.docID]...)
+	}
+	return set.and(t.masks[t.repos[t.docID]])
+}
+
+// inBranches returns true if document docID matches the query.
+func (t *branchQueryMatchTree) inBranches(docID uint32) bool {
+	var high []uint64
+	if t.fileMasksHigh != nil {
+		high = t.fileMasksHigh[docID]
+	}
+	return inBranches(t.fileMasks[docID], high, t.masks[t.repos[docID]])
 }
 
 type symbolRegexpMatchTree struct {
@@ -236,6 +252,59 @@ func (t *symbolRegexpMatchTree) matches(cp *contentProvider, cost int, known map
 	return len(t.found) > 0, true
 }
 
+// referenceMatchTree keeps the matches of its child which are references to
+// a symbol, see Document.References.
+type referenceMatchTree struct {
+	matchTree
+
+	evaluated bool
+	found     []*candidateMatch
+}
+
+func (t *referenceMatchTree) prepare(doc uint32) {
+	t.matchTree.prepare(doc)
+	t.evaluated = false
+}
+
+func (t *referenceMatchTree) matches(cp *contentProvider, cost int, known map[matchTree]bool) (bool, bool) {
+	if t.evaluated {
+		return len(t.found) > 0, true
+	}
+
+	v, sure := evalMatchTree(cp, cost, known, t.matchTree)
+	if !sure {
+		return false, false
+	}
+
+	found := t.found[:0]
+	if v {
+		sections := cp.referenceSections()
+		visitMatches(t.matchTree, known, func(mt matchTree) {
+			var cands []*candidateMatch
+			switch s := mt.(type) {
+			case *substrMatchTree:
+				cands = s.current
+			case *regexpMatchTree:
+				cands = s.found
+			case *wordMatchTree:
+				cands = s.found
+			}
+			for _, cm := range cands {
+				if cm.fileName {
+					continue
+				}
+				if _, ok := findSection(sections, cm.byteOffset, cm.byteMatchSz); ok {
+					found = append(found, cm)
+				}
+			}
+		})
+	}
+	t.found = found
+	t.evaluated = true
+
+	return len(t.found) > 0, true
+}
+
 type symbolSubstrMatchTree struct {
 	*substrMatchTree
 
@@ -417,7 +486,7 @@ func (t *branchQueryMatchTree) nextDoc() uint32 {
 	}
 
 	for i := start; i < uint32(len(t.fileMasks)); i++ {
-		if (t.masks[t.repos[i]] & t.fileMasks[i]) != 0 {
+		if t.inBranches(i) {
 			return i
 		}
 	}
@@ -491,6 +560,10 @@ func (t *symbolRegexpMatchTree) String() string {
 	return fmt.Sprintf("symbol(%v)", t.matchTree)
 }
 
+func (t *referenceMatchTree) String() string {
+	return fmt.Sprintf("reference(%v)", t.matchTree)
+}
+
 // visitMatches visits all atoms in matchTree. Note: This visits
 // noVisitMatchTree. For collecting matches use visitMatches.
 func visitMatchTree(t matchTree, f func(matchTree)) {
@@ -515,6 +588,8 @@ func visitMatchTree(t matchTree, f func(matchTree)) {
 		visitMatchTree(s.substrMatchTree, f)
 	case *symbolRegexpMatchTree:
 		visitMatchTree(s.matchTree, f)
+	case *referenceMatchTree:
+		visitMatchTree(s.matchTree, f)
 	default:
 		f(t)
 	}
@@ -686,7 +761,7 @@ func (t *orMatchTree) matches(cp *contentProvider, cost int, known map[matchTree
 }
 
 func (t *branchQueryMatchTree) matches(cp *contentProvider, cost int, known map[matchTree]bool) (bool, bool) {
-	return t.branchMask() != 0, true
+	return t.inBranches(t.docID), true
 }
 
 func (t *regexpMatchTree) matches(cp *contentProvider, cost int, known map[matchTree]bool) (bool, bool) {
@@ -920,6 +995,10 @@ func (d *indexData) newMatchTree(q query.Q, opt matchTreeOpt) (matchTree, error)
 		}, err
 
 	case *query.Type:
+		if s.Type == query.TypeCommit {
+			// Commit documents are selected in Search, see commitFilter.
+			return d.newMatchTree(s.Child, opt)
+		}
 		if s.Type != query.TypeFileName {
 			break
 		}
@@ -937,17 +1016,17 @@ func (d *indexData) newMatchTree(q query.Q, opt matchTreeOpt) (matchTree, error)
 		return d.newSubstringMatchTree(s)
 
 	case *query.Branch:
-		masks := make([]uint64, 0, len(d.repoMetaData))
+		masks := make([]branchSet, 0, len(d.repoMetaData))
 		if s.Pattern == "HEAD" {
 			for i := 0; i < len(d.repoMetaData); i++ {
-				masks = append(masks, 1)
+				masks = append(masks, branchSet{1})
 			}
 		} else {
 			for _, branchIDs := range d.branchIDs {
-				mask := uint64(0)
-				for nm, m := range branchIDs {
+				var mask branchSet
+				for nm, id := range branchIDs {
 					if (s.Exact && nm == s.Pattern) || (!s.Exact && strings.Contains(nm, s.Pattern)) {
-						mask |= uint64(m)
+						mask.add(id)
 					}
 				}
 				masks = append(masks, mask)
@@ -955,9 +1034,10 @@ func (d *indexData) newMatchTree(q query.Q, opt matchTreeOpt) (matchTree, error)
 
 		}
 		return &branchQueryMatchTree{
-			masks:     masks,
-			fileMasks: d.fileBranchMasks,
-			repos:     d.repos,
+			masks:         masks,
+			fileMasks:     d.fileBranchMasks,
+			fileMasksHigh: d.fileBranchMasksHigh,
+			repos:         d.repos,
 		}, nil
 	case *query.Const:
 		if s.Value {
@@ -978,6 +1058,19 @@ func (d *indexData) newMatchTree(q query.Q, opt matchTreeOpt) (matchTree, error)
 			},
 		}, nil
 
+	case *query.Author:
+		want := make([]bool, len(d.authorNames)+1)
+		for i, a := range d.authorNames {
+			want[i+1] = s.Regexp.MatchString(a)
+		}
+		return &docMatchTree{
+			reason:  "author",
+			numDocs: d.numDocs(),
+			predicate: func(docID uint32) bool {
+				return d.fileAuthors != nil && want[d.fileAuthors[docID]]
+			},
+		}, nil
+
 	case *query.Symbol:
 		// Disable WordMatchTree since we don't support it in symbols yet.
 		optCopy := opt
@@ -1014,6 +1107,14 @@ func (d *indexData) newMatchTree(q query.Q, opt matchTreeOpt) (matchTree, error)
 			matchTree: subMT,
 		}, nil
 
+	case *query.Reference:
+		subMT, err := d.newMatchTree(s.Expr, opt)
+		if err != nil {
+			return nil, err
+		}
+
+		return &referenceMatchTree{matchTree: subMT}, nil
+
 	case *query.FileNameSet:
 		return &docMatchTree{
 			reason:  "FileNameSet",
@@ -1026,12 +1127,15 @@ func (d *indexData) newMatchTree(q query.Q, opt matchTreeOpt) (matchTree, error)
 		}, nil
 
 	case *query.BranchesRepos:
-		reposBranchesWant := make([]uint64, len(d.repoMetaData))
+		reposBranchesWant := make([]branchSet, len(d.repoMetaData))
 		for repoIdx := range d.repoMetaData {
-			var mask uint64
+			var mask branchSet
 			for _, br := range s.List {
-				if br.Repos.Contains(d.repoMetaData[repoIdx].ID) {
-					mask |= uint64(d.branchIDs[repoIdx][br.Branch])
+				if !br.Repos.Contains(d.repoMetaData[repoIdx].ID) {
+					continue
+				}
+				if id, ok := d.branchIDs[repoIdx][br.Branch]; ok {
+					mask.add(id)
 				}
 			}
 			reposBranchesWant[repoIdx] = mask
@@ -1040,7 +1144,7 @@ func (d *indexData) newMatchTree(q query.Q, opt matchTreeOpt) (matchTree, error)
 			reason:  "BranchesRepos",
 			numDocs: d.numDocs(),
 			predicate: func(docID uint32) bool {
-				return d.fileBranchMasks[docID]&reposBranchesWant[d.repos[docID]] != 0
+				return inBranches(d.fileBranchMasks[docID], d.fileBranchesHigh(docID), reposBranchesWant[d.repos[docID]])
 			},
 		}, nil
 
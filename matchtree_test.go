@@ -338,7 +338,7 @@ func TestBranchesRepos(t *testing.T) {
 		},
 		fileBranchMasks: []uint64{1, 1, 1, 2, 1, 2, 1},
 		repos:           []uint16{0, 0, 1, 1, 1, 1, 1},
-		branchIDs:       []map[string]uint{{"HEAD": 1}, {"HEAD": 1, "b1": 2}},
+		branchIDs:       []map[string]int{{"HEAD": 0}, {"HEAD": 0, "b1": 1}},
 	}
 
 	mt, err := d.newMatchTree(&query.BranchesRepos{List: []query.BranchRepos{
@@ -121,6 +121,7 @@ func merge(ds ...*indexData) (*IndexBuilder, error) {
 				if err := ib.setRepository(&d.repoMetaData[repoID]); err != nil {
 					return nil, err
 				}
+				addSecretFindings(d, ib, repoID)
 			}
 
 			if err := addDocument(d, ib, repoID, docID); err != nil {
@@ -192,6 +193,7 @@ func explode(dstDir string, f IndexFile, ibFuncs ...indexBuilderFunc) (map[strin
 			if err := ib.setRepository(&d.repoMetaData[repoID]); err != nil {
 				return shardNames, err
 			}
+			addSecretFindings(d, ib, repoID)
 		}
 
 		err := addDocument(d, ib, repoID, docID)
@@ -233,21 +235,33 @@ func addDocument(d *indexData, ib *IndexBuilder, repoID int, docID uint32) error
 		doc.SymbolsMetaData[i] = d.symbols.data(d.fileEndSymbol[docID] + uint32(i))
 	}
 
+	if doc.References, _, err = d.readReferences(docID, nil); err != nil {
+		return err
+	}
+
+	if doc.Encoding, err = d.readEncoding(docID); err != nil {
+		return err
+	}
+
+	doc.Commit = d.isCommit(docID)
+	doc.Author = d.author(docID)
+
 	// calculate branches
-	{
-		mask := d.fileBranchMasks[docID]
-		id := uint32(1)
-		for mask != 0 {
-			if mask&0x1 != 0 {
-				doc.Branches = append(doc.Branches, d.branchNames[repoID][uint(id)])
-			}
-			id <<= 1
-			mask >>= 1
-		}
+	for _, id := range d.fileBranches(docID).ids() {
+		doc.Branches = append(doc.Branches, d.branchNames[repoID][id])
 	}
 	return ib.Add(doc)
 }
 
+// addSecretFindings copies the secret findings of a repository in d to ib.
+func addSecretFindings(d *indexData, ib *IndexBuilder, repoID int) {
+	for _, f := range d.secretFindings {
+		if f.Repository == d.repoMetaData[repoID].Name {
+			ib.AddSecretFindings(f)
+		}
+	}
+}
+
 // copied from builder package to avoid circular imports.
 func hashString(s string) string {
 	h := sha1.New()
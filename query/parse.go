@@ -125,6 +125,13 @@ func parseExpr(in []byte) (Q, int, error) {
 		}
 
 		expr = &Repo{r}
+	case tokAuthor:
+		r, err := regexp.Compile(text)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		expr = &Author{r}
 	case tokArchived:
 		switch text {
 		case "yes":
@@ -191,6 +198,29 @@ func parseExpr(in []byte) (Q, int, error) {
 		}
 
 		expr = &Symbol{q}
+	case tokRef:
+		if text == "" {
+			return nil, 0, fmt.Errorf("the ref: atom must have an argument")
+		}
+
+		q, err := RegexpQuery(text, false, false)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		expr = &Reference{q}
+	case tokCommit:
+		if text == "" {
+			return nil, 0, fmt.Errorf("the commit: atom must have an argument")
+		}
+
+		q, err := RegexpQuery(text, false, false)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		// Like type:commit, this is lifted into a root below.
+		expr = &Type{Type: TypeCommit, Child: q}
 	case tokParenClose:
 		// Caller must consume paren.
 		expr = nil
@@ -235,8 +265,10 @@ func parseExpr(in []byte) (Q, int, error) {
 			t = TypeFileName
 		case "repo":
 			t = TypeRepo
+		case "commit":
+			t = TypeCommit
 		default:
-			return nil, 0, fmt.Errorf("query: unknown type argument %q, want {filematch,filename,repo}", text)
+			return nil, 0, fmt.Errorf("query: unknown type argument %q, want {filematch,filename,repo,commit}", text)
 		}
 		// Later we will lift this into a root, like we do for caseQ
 		expr = &Type{Type: t, Child: nil}
@@ -344,6 +376,9 @@ func parseExprList(in []byte) ([]Q, int, error) {
 			if s.Type < typeT {
 				typeT = s.Type
 			}
+			if s.Child != nil {
+				newQS = append(newQS, s.Child)
+			}
 		default:
 			newQS = append(newQS, q)
 		}
@@ -393,12 +428,17 @@ const (
 	tokArchived   = 15
 	tokPublic     = 16
 	tokFork       = 17
+	tokRef        = 18
+	tokCommit     = 19
+	tokAuthor     = 20
 )
 
 var tokNames = map[int]string{
 	tokArchived:   "Archived",
+	tokAuthor:     "Author",
 	tokBranch:     "Branch",
 	tokCase:       "Case",
+	tokCommit:     "Commit",
 	tokError:      "Error",
 	tokFile:       "File",
 	tokFork:       "Fork",
@@ -408,6 +448,7 @@ var tokNames = map[int]string{
 	tokParenOpen:  "ParenOpen",
 	tokPublic:     "Public",
 	tokRegex:      "Regex",
+	tokRef:        "Reference",
 	tokRepo:       "Repo",
 	tokText:       "Text",
 	tokLang:       "Language",
@@ -417,16 +458,19 @@ var tokNames = map[int]string{
 
 var prefixes = map[string]int{
 	"archived:": tokArchived,
+	"author:":   tokAuthor,
 	"b:":        tokBranch,
 	"branch:":   tokBranch,
 	"c:":        tokContent,
 	"case:":     tokCase,
+	"commit:":   tokCommit,
 	"content:":  tokContent,
 	"f:":        tokFile,
 	"file:":     tokFile,
 	"fork:":     tokFork,
 	"public:":   tokPublic,
 	"r:":        tokRepo,
+	"ref:":      tokRef,
 	"regex:":    tokRegex,
 	"repo:":     tokRepo,
 	"lang:":     tokLang,
@@ -83,6 +83,7 @@ func TestParseQuery(t *testing.T) {
 		{"aBc[p-q] case:auto", &Regexp{Regexp: mustParseRE("aBc[p-q]"), CaseSensitive: true}},
 		{"repo:go", &Repo{regexp.MustCompile("go")}},
 		{"repo:.*", &Repo{Regexp: regexp.MustCompile(".*")}},
+		{"author:jane abc", NewAnd(&Author{Regexp: regexp.MustCompile("jane")}, &Substring{Pattern: "abc"})},
 
 		{"file:\"\"", &Const{true}},
 		{"abc.*def", &Regexp{Regexp: mustParseRE("abc.*def")}},
@@ -98,6 +99,8 @@ func TestParseQuery(t *testing.T) {
 		{"sym:Pqr", &Symbol{&Substring{Pattern: "Pqr", CaseSensitive: true}}},
 		{"sym:.*", &Symbol{&Regexp{Regexp: mustParseRE(".*")}}},
 		{"sym:a(b|d)e", &Symbol{&Regexp{Regexp: mustParseRE("a[bd]e")}}},
+		{"ref:pqr", &Reference{&Substring{Pattern: "pqr"}}},
+		{"ref:Pqr", &Reference{&Substring{Pattern: "Pqr", CaseSensitive: true}}},
 
 		// case
 		{"abc case:yes", &Substring{Pattern: "abc", CaseSensitive: true}},
@@ -113,6 +116,10 @@ func TestParseQuery(t *testing.T) {
 		{"type:repo abc", &Type{Type: TypeRepo, Child: &Substring{Pattern: "abc"}}},
 		{"type:file abc def", &Type{Type: TypeFileName, Child: NewAnd(&Substring{Pattern: "abc"}, &Substring{Pattern: "def"})}},
 		{"(type:repo abc) def", NewAnd(&Type{Type: TypeRepo, Child: &Substring{Pattern: "abc"}}, &Substring{Pattern: "def"})},
+		{"type:commit abc", &Type{Type: TypeCommit, Child: &Substring{Pattern: "abc"}}},
+		{"commit:abc", &Type{Type: TypeCommit, Child: &Substring{Pattern: "abc"}}},
+		{"commit:abc def", &Type{Type: TypeCommit, Child: NewAnd(&Substring{Pattern: "abc"}, &Substring{Pattern: "def"})}},
+		{"commit:abc repo:foo", &Type{Type: TypeCommit, Child: NewAnd(&Substring{Pattern: "abc"}, &Repo{Regexp: regexp.MustCompile("foo")})}},
 
 		// errors.
 		{"--", nil},
@@ -121,6 +128,8 @@ func TestParseQuery(t *testing.T) {
 		{"case:foo", nil},
 
 		{"sym:", nil},
+		{"ref:", nil},
+		{"commit:", nil},
 		{"abc or", nil},
 		{"or abc", nil},
 		{"def or or abc", nil},
@@ -141,6 +141,16 @@ func (s *Symbol) String() string {
 	return fmt.Sprintf("sym:%s", s.Expr)
 }
 
+// Reference finds a string that is a reference to a symbol, e.g. a call or a
+// use of a type, as opposed to its definition.
+type Reference struct {
+	Expr Q
+}
+
+func (s *Reference) String() string {
+	return fmt.Sprintf("ref:%s", s.Expr)
+}
+
 type caseQ struct {
 	Flavor string
 }
@@ -186,6 +196,26 @@ func (q *Repo) GobDecode(data []byte) error {
 	return err
 }
 
+// Author matches documents whose last author, formatted as "Name <email>",
+// matches Regexp, see zoekt.Document.Author.
+type Author struct {
+	Regexp *regexp.Regexp
+}
+
+func (q *Author) String() string {
+	return fmt.Sprintf("author:%s", q.Regexp.String())
+}
+
+func (q Author) GobEncode() ([]byte, error) {
+	return []byte(q.Regexp.String()), nil
+}
+
+func (q *Author) GobDecode(data []byte) error {
+	var err error
+	q.Regexp, err = regexp.Compile(string(data))
+	return err
+}
+
 // RepoRegexp is a Sourcegraph addition which searches documents where the
 // repository name matches Regexp.
 type RepoRegexp struct {
@@ -365,6 +395,7 @@ const (
 	TypeFileMatch uint8 = iota
 	TypeFileName
 	TypeRepo
+	TypeCommit
 )
 
 // Type changes the result type returned.
@@ -381,6 +412,8 @@ func (q *Type) String() string {
 		return fmt.Sprintf("(type:filename %s)", q.Child)
 	case TypeRepo:
 		return fmt.Sprintf("(type:repo %s)", q.Child)
+	case TypeCommit:
+		return fmt.Sprintf("(type:commit %s)", q.Child)
 	default:
 		return fmt.Sprintf("(type:UNKNOWN %s)", q.Child)
 	}
@@ -437,6 +470,12 @@ func (q *Symbol) setCase(k string) {
 	}
 }
 
+func (q *Reference) setCase(k string) {
+	if sc, ok := q.Expr.(setCaser); ok {
+		sc.setCase(k)
+	}
+}
+
 func (q *Regexp) setCase(k string) {
 	switch k {
 	case "yes":
@@ -670,13 +709,14 @@ func evalConstants(q Q) Q {
 		return &Not{ch}
 	case *Type:
 		ch := evalConstants(s.Child)
-		if _, ok := ch.(*Const); ok {
+		if _, ok := ch.(*Const); ok && s.Type != TypeCommit {
 			// If q is the root query, then evaluating this to a const changes
 			// the type of result we will return. However, the only case this
 			// makes sense is `type:repo TRUE` to return all repos or
 			// `type:file TRUE` to return all filenames. For other cases we
 			// want to do this constant folding though, so we allow the
-			// unexpected behaviour mentioned previously.
+			// unexpected behaviour mentioned previously. type:commit is
+			// kept, since it selects different documents.
 			return ch
 		}
 		return &Type{Child: ch, Type: s.Type}
@@ -3,6 +3,7 @@ package query
 import (
 	"fmt"
 	"regexp/syntax"
+	"sort"
 
 	"github.com/RoaringBitmap/roaring"
 	"github.com/grafana/regexp"
@@ -17,12 +18,16 @@ func QToProto(q Q) *proto.Q {
 		return &proto.Q{Query: &proto.Q_Regexp{Regexp: v.ToProto()}}
 	case *Symbol:
 		return &proto.Q{Query: &proto.Q_Symbol{Symbol: v.ToProto()}}
+	case *Reference:
+		return &proto.Q{Query: &proto.Q_Reference{Reference: v.ToProto()}}
 	case *Language:
 		return &proto.Q{Query: &proto.Q_Language{Language: v.ToProto()}}
 	case *Const:
 		return &proto.Q{Query: &proto.Q_Const{Const: v.Value}}
 	case *Repo:
 		return &proto.Q{Query: &proto.Q_Repo{Repo: v.ToProto()}}
+	case *Author:
+		return &proto.Q{Query: &proto.Q_Author{Author: v.ToProto()}}
 	case *RepoRegexp:
 		return &proto.Q{Query: &proto.Q_RepoRegexp{RepoRegexp: v.ToProto()}}
 	case *BranchesRepos:
@@ -61,12 +66,16 @@ func QFromProto(p *proto.Q) (Q, error) {
 		return RegexpFromProto(v.Regexp)
 	case *proto.Q_Symbol:
 		return SymbolFromProto(v.Symbol)
+	case *proto.Q_Reference:
+		return ReferenceFromProto(v.Reference)
 	case *proto.Q_Language:
 		return LanguageFromProto(v.Language), nil
 	case *proto.Q_Const:
 		return &Const{Value: v.Const}, nil
 	case *proto.Q_Repo:
 		return RepoFromProto(v.Repo)
+	case *proto.Q_Author:
+		return AuthorFromProto(v.Author)
 	case *proto.Q_RepoRegexp:
 		return RepoRegexpFromProto(v.RepoRegexp)
 	case *proto.Q_BranchesRepos:
@@ -133,6 +142,23 @@ func (s *Symbol) ToProto() *proto.Symbol {
 	}
 }
 
+func ReferenceFromProto(p *proto.Reference) (*Reference, error) {
+	expr, err := QFromProto(p.GetExpr())
+	if err != nil {
+		return nil, err
+	}
+
+	return &Reference{
+		Expr: expr,
+	}, nil
+}
+
+func (s *Reference) ToProto() *proto.Reference {
+	return &proto.Reference{
+		Expr: QToProto(s.Expr),
+	}
+}
+
 func LanguageFromProto(p *proto.Language) *Language {
 	return &Language{
 		Language: p.GetLanguage(),
@@ -159,6 +185,22 @@ func (q *Repo) ToProto() *proto.Repo {
 	}
 }
 
+func AuthorFromProto(p *proto.Author) (*Author, error) {
+	r, err := regexp.Compile(p.GetRegexp())
+	if err != nil {
+		return nil, err
+	}
+	return &Author{
+		Regexp: r,
+	}, nil
+}
+
+func (q *Author) ToProto() *proto.Author {
+	return &proto.Author{
+		Regexp: q.Regexp.String(),
+	}
+}
+
 func RepoRegexpFromProto(p *proto.RepoRegexp) (*RepoRegexp, error) {
 	r, err := regexp.Compile(p.GetRegexp())
 	if err != nil {
@@ -273,6 +315,7 @@ func (q *FileNameSet) ToProto() *proto.FileNameSet {
 	for name := range q.Set {
 		s = append(s, name)
 	}
+	sort.Strings(s)
 	return &proto.FileNameSet{
 		Set: s,
 	}
@@ -292,6 +335,8 @@ func TypeFromProto(p *proto.Type) (*Type, error) {
 		kind = TypeFileName
 	case proto.Type_KIND_REPO:
 		kind = TypeRepo
+	case proto.Type_KIND_COMMIT:
+		kind = TypeCommit
 	}
 
 	return &Type{
@@ -310,6 +355,8 @@ func (q *Type) ToProto() *proto.Type {
 		kind = proto.Type_KIND_FILE_NAME
 	case TypeRepo:
 		kind = proto.Type_KIND_REPO
+	case TypeCommit:
+		kind = proto.Type_KIND_COMMIT
 	}
 
 	return &proto.Type{
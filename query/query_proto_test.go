@@ -22,6 +22,9 @@ func TestQueryRoundtrip(t *testing.T) {
 				Language: "go",
 			},
 		},
+		&Reference{
+			Expr: &Substring{Pattern: "ParseConfig"},
+		},
 		&Language{
 			Language: "typescript",
 		},
@@ -34,6 +37,9 @@ func TestQueryRoundtrip(t *testing.T) {
 		&RepoRegexp{
 			Regexp: regexp.MustCompile("github.com/foo.*"),
 		},
+		&Author{
+			Regexp: regexp.MustCompile("jane@example\\.com"),
+		},
 		&BranchesRepos{
 			List: []BranchRepos{{
 				Branch: "test",
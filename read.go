@@ -227,8 +227,8 @@ func canReadVersion(md *IndexMetadata) bool {
 func (r *reader) readIndexData(toc *indexTOC) (*indexData, error) {
 	d := indexData{
 		file:        r.r,
-		branchIDs:   []map[string]uint{},
-		branchNames: []map[uint]string{},
+		branchIDs:   []map[string]int{},
+		branchNames: [][]string{},
 	}
 
 	repos, md, err := r.readMetadata(toc)
@@ -258,6 +258,10 @@ func (r *reader) readIndexData(toc *indexTOC) (*indexData, error) {
 	d.newlinesIndex = toc.newlines.relativeIndex()
 	d.docSectionsStart = toc.fileSections.data.off
 	d.docSectionsIndex = toc.fileSections.relativeIndex()
+	d.referencesStart = toc.fileReferences.data.off
+	d.referencesIndex = toc.fileReferences.relativeIndex()
+	d.encodingsStart = toc.fileEncodings.data.off
+	d.encodingsIndex = toc.fileEncodings.relativeIndex()
 
 	d.symbols.symKindIndex = toc.symbolKindMap.relativeIndex()
 	d.fileEndSymbol, err = readSectionU32(d.file, toc.fileEndSymbol)
@@ -298,6 +302,21 @@ func (r *reader) readIndexData(toc *indexTOC) (*indexData, error) {
 		return nil, err
 	}
 
+	if err := d.readBranchMasksHigh(toc); err != nil {
+		return nil, err
+	}
+
+	if err := d.readAuthors(toc); err != nil {
+		return nil, err
+	}
+
+	if toc.fileKinds.sz > 0 {
+		d.fileKinds, err = d.readSectionBlob(toc.fileKinds)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	d.fileNameContent, err = d.readSectionBlob(toc.fileNames.data)
 	if err != nil {
 		return nil, err
@@ -311,12 +330,11 @@ func (r *reader) readIndexData(toc *indexTOC) (*indexData, error) {
 	}
 
 	for _, md := range d.repoMetaData {
-		repoBranchIDs := make(map[string]uint, len(md.Branches))
-		repoBranchNames := make(map[uint]string, len(md.Branches))
+		repoBranchIDs := make(map[string]int, len(md.Branches))
+		repoBranchNames := make([]string, len(md.Branches))
 		for j, br := range md.Branches {
-			id := uint(1) << uint(j)
-			repoBranchIDs[br.Name] = id
-			repoBranchNames[id] = br.Name
+			repoBranchIDs[br.Name] = j
+			repoBranchNames[j] = br.Name
 		}
 		d.branchIDs = append(d.branchIDs, repoBranchIDs)
 		d.branchNames = append(d.branchNames, repoBranchNames)
@@ -391,6 +409,10 @@ func (r *reader) readIndexData(toc *indexTOC) (*indexData, error) {
 		d.repos = make([]uint16, len(d.fileBranchMasks))
 	}
 
+	if d.secretFindings, err = readSecretFindings(d.file, toc.secretFindings); err != nil {
+		return nil, err
+	}
+
 	if err := d.calculateStats(); err != nil {
 		return nil, err
 	}
@@ -535,6 +557,88 @@ func (d *indexData) readDocSections(i uint32, buf []DocumentSection) ([]Document
 	return unmarshalDocSections(blob, buf), sec.sz, nil
 }
 
+// readReferences returns the reference sections of document i. Shards
+// written before references were indexed have none.
+func (d *indexData) readReferences(i uint32, buf []DocumentSection) ([]DocumentSection, uint32, error) {
+	if len(d.referencesIndex) == 0 {
+		return nil, 0, nil
+	}
+	sec := simpleSection{
+		off: d.referencesStart + d.referencesIndex[i],
+		sz:  d.referencesIndex[i+1] - d.referencesIndex[i],
+	}
+	blob, err := d.readSectionBlob(sec)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return unmarshalDocSections(blob, buf), sec.sz, nil
+}
+
+// readEncoding returns the original encoding of document i, see
+// Document.Encoding.
+func (d *indexData) readEncoding(i uint32) (string, error) {
+	if len(d.encodingsIndex) == 0 {
+		return "", nil
+	}
+	blob, err := d.readSectionBlob(simpleSection{
+		off: d.encodingsStart + d.encodingsIndex[i],
+		sz:  d.encodingsIndex[i+1] - d.encodingsIndex[i],
+	})
+	if err != nil {
+		return "", err
+	}
+	return string(blob), nil
+}
+
+// readAuthors reads the fileAuthors and authorNames sections, which are only
+// present if an author was recorded.
+func (d *indexData) readAuthors(toc *indexTOC) error {
+	if toc.fileAuthors.sz == 0 {
+		return nil
+	}
+	ids, err := readSectionU32(d.file, toc.fileAuthors)
+	if err != nil {
+		return err
+	}
+	index := toc.authorNames.relativeIndex()
+	blob, err := d.readSectionBlob(toc.authorNames.data)
+	if err != nil {
+		return err
+	}
+	for i := 0; i+1 < len(index); i++ {
+		d.authorNames = append(d.authorNames, string(blob[index[i]:index[i+1]]))
+	}
+	d.fileAuthors = ids
+	return nil
+}
+
+// author returns the author of document docID, see Document.Author.
+func (d *indexData) author(docID uint32) string {
+	if d.fileAuthors == nil || d.fileAuthors[docID] == 0 {
+		return ""
+	}
+	return d.authorNames[d.fileAuthors[docID]-1]
+}
+
+// readBranchMasksHigh reads the branchMasksHigh section, which is only
+// present if a repository has more than 64 branches.
+func (d *indexData) readBranchMasksHigh(toc *indexTOC) error {
+	index := toc.branchMasksHigh.relativeIndex()
+	if len(index) == 0 {
+		return nil
+	}
+	blob, err := d.readSectionBlob(toc.branchMasksHigh.data)
+	if err != nil {
+		return err
+	}
+	d.fileBranchMasksHigh = make([][]uint64, len(index)-1)
+	for i := range d.fileBranchMasksHigh {
+		d.fileBranchMasksHigh[i] = unmarshalBranchMasksHigh(blob[index[i]:index[i+1]])
+	}
+	return nil
+}
+
 func (d *indexData) readRanks(toc *indexTOC) error {
 	blob, err := d.readSectionBlob(toc.ranks)
 	if err != nil {
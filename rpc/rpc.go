@@ -131,6 +131,7 @@ var once sync.Once
 func RegisterGob() {
 	once.Do(func() {
 		gobRegister(&query.And{})
+		gobRegister(&query.Author{})
 		gobRegister(&query.BranchRepos{})
 		gobRegister(&query.BranchesRepos{})
 		gobRegister(&query.Branch{})
@@ -140,6 +141,7 @@ func RegisterGob() {
 		gobRegister(&query.Language{})
 		gobRegister(&query.Not{})
 		gobRegister(&query.Or{})
+		gobRegister(&query.Reference{})
 		gobRegister(&query.Regexp{})
 		gobRegister(&query.RepoRegexp{})
 		gobRegister(&query.RepoSet{})
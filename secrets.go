@@ -0,0 +1,63 @@
+package zoekt
+
+import (
+	"encoding/json"
+)
+
+// SecretAction is what the builder did with a document containing a secret.
+type SecretAction string
+
+const (
+	// SecretSkipped documents are not indexed, see Document.SkipReason.
+	SecretSkipped SecretAction = "skipped"
+
+	// SecretRedacted documents are indexed with the secret replaced.
+	SecretRedacted SecretAction = "redacted"
+)
+
+// SecretFinding records a secret found in a document while indexing. Findings
+// are stored in the shard of the document for security review, see
+// ReadSecretFindings. The secret itself is never recorded.
+type SecretFinding struct {
+	Repository string
+	FileName   string
+
+	// Rule is the ID of the rule which matched.
+	Rule string
+
+	// Line is the 1-based line number of the secret.
+	Line int
+
+	Action SecretAction
+}
+
+// AddSecretFindings records findings in the shard. The documents of the
+// findings should be added to b as well.
+func (b *IndexBuilder) AddSecretFindings(findings ...SecretFinding) {
+	b.secretFindings = append(b.secretFindings, findings...)
+}
+
+// ReadSecretFindings returns the secret findings recorded in the shard f.
+func ReadSecretFindings(f IndexFile) ([]SecretFinding, error) {
+	rd := &reader{r: f}
+	var toc indexTOC
+	if err := rd.readTOC(&toc); err != nil {
+		return nil, err
+	}
+	return readSecretFindings(f, toc.secretFindings)
+}
+
+func readSecretFindings(f IndexFile, sec simpleSection) ([]SecretFinding, error) {
+	if sec.sz == 0 {
+		return nil, nil
+	}
+	blob, err := f.Read(sec.off, sec.sz)
+	if err != nil {
+		return nil, err
+	}
+	var findings []SecretFinding
+	if err := json.Unmarshal(blob, &findings); err != nil {
+		return nil, err
+	}
+	return findings, nil
+}
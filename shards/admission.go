@@ -0,0 +1,132 @@
+package shards
+
+import (
+	"runtime"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/sourcegraph/zoekt"
+	"github.com/sourcegraph/zoekt/query"
+)
+
+var (
+	metricAdmissionTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "zoekt_search_admission_total",
+		Help: "The total number of searches by admission control decision",
+	}, []string{"decision"})
+	metricEstimatedCostBytes = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "zoekt_search_estimated_cost_bytes",
+		Help:    "The estimated number of bytes a search reads, see zoekt.Cost.Bytes",
+		Buckets: prometheus.ExponentialBuckets(1<<10, 4, 14), // 1KiB -> 256GiB
+	})
+)
+
+// AdmissionOptions configures admission control of searches based on their
+// estimated cost, see zoekt.Cost. A threshold of 0 disables it. With a shard
+// memory budget, only the shards which are resident are estimated.
+type AdmissionOptions struct {
+	// DowngradeBytes is the estimated cost above which a search runs at
+	// batch priority with smaller match limits.
+	DowngradeBytes uint64
+
+	// RejectBytes is the estimated cost above which a search is rejected
+	// with a *zoekt.CostError.
+	RejectBytes uint64
+}
+
+func (o AdmissionOptions) enabled() bool {
+	return o.DowngradeBytes > 0 || o.RejectBytes > 0
+}
+
+// downgradeLimitDivisor is how much the match limits of downgraded searches
+// are reduced by.
+const downgradeLimitDivisor = 10
+
+// admission is the decision of admission control for a search.
+type admission struct {
+	// batch is true if the search should run at batch priority.
+	batch bool
+
+	// opts are the options to search with.
+	opts *zoekt.SearchOptions
+}
+
+// admit estimates the cost of searching q over shards and decides whether and
+// how the search runs. It returns a *zoekt.CostError if the search is
+// rejected.
+func (o AdmissionOptions) admit(shards []*rankedShard, q query.Q, opts *zoekt.SearchOptions) (admission, error) {
+	if !o.enabled() || opts.EstimateDocCount {
+		return admission{opts: opts}, nil
+	}
+
+	cost := estimateCost(shards, q)
+	metricEstimatedCostBytes.Observe(float64(cost.Bytes()))
+
+	if o.RejectBytes > 0 && cost.Bytes() > o.RejectBytes {
+		metricAdmissionTotal.WithLabelValues("rejected").Inc()
+		return admission{}, &zoekt.CostError{Cost: cost, Limit: o.RejectBytes}
+	}
+
+	if o.DowngradeBytes > 0 && cost.Bytes() > o.DowngradeBytes {
+		metricAdmissionTotal.WithLabelValues("downgraded").Inc()
+		return admission{batch: true, opts: downgradeOptions(opts)}, nil
+	}
+
+	metricAdmissionTotal.WithLabelValues("admitted").Inc()
+	return admission{opts: opts}, nil
+}
+
+// estimateCost sums the estimated costs of searching q over the shards which
+// can estimate it. The shards are split between up to GOMAXPROCS workers.
+func estimateCost(shards []*rankedShard, q query.Q) zoekt.Cost {
+	q = query.Simplify(q)
+	shards, q = selectRepoSet(shards, q)
+
+	workers := runtime.GOMAXPROCS(0)
+	if workers > len(shards) {
+		workers = len(shards)
+	}
+
+	costs := make([]zoekt.Cost, workers)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := w; i < len(shards); i += workers {
+				if ce, ok := shards[i].Searcher.(zoekt.CostEstimator); ok {
+					costs[w].Add(ce.EstimateCost(q))
+				}
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	var cost zoekt.Cost
+	for _, c := range costs {
+		cost.Add(c)
+	}
+	return cost
+}
+
+// downgradeOptions returns a copy of opts with smaller match limits.
+func downgradeOptions(opts *zoekt.SearchOptions) *zoekt.SearchOptions {
+	o := *opts
+	o.SetDefaults()
+
+	reduce := func(n int) int {
+		if n == 0 {
+			return 0
+		}
+		if n /= downgradeLimitDivisor; n == 0 {
+			return 1
+		}
+		return n
+	}
+	o.ShardMaxMatchCount = reduce(o.ShardMaxMatchCount)
+	o.TotalMaxMatchCount = reduce(o.TotalMaxMatchCount)
+	o.MaxDocDisplayCount = reduce(o.MaxDocDisplayCount)
+	return &o
+}
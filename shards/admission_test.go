@@ -0,0 +1,82 @@
+package shards
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/sourcegraph/zoekt"
+	"github.com/sourcegraph/zoekt/query"
+	"github.com/sourcegraph/zoekt/stream"
+)
+
+func TestAdmission(t *testing.T) {
+	ss := newShardedSearcher(1)
+	ss.markReady()
+
+	repo := &zoekt.Repository{Name: "repo", ID: hash("repo")}
+	ss.replace(map[string]zoekt.Searcher{
+		"repo": searcherForTest(t, testIndexBuilder(t, repo,
+			zoekt.Document{Name: "a", Content: []byte("needle in a haystack")},
+			zoekt.Document{Name: "b", Content: []byte("just hay")},
+		)),
+	})
+
+	ctx := context.Background()
+	cheap := &query.Substring{Pattern: "needle"}
+	expensive, err := query.Parse("regex:.")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The regexp has to scan all the content, while the substring only reads
+	// two small posting lists.
+	ss.admission = AdmissionOptions{RejectBytes: 10}
+	if _, err := ss.Search(ctx, cheap, &zoekt.SearchOptions{}); err != nil {
+		t.Fatalf("cheap search: %v", err)
+	}
+
+	var costErr *zoekt.CostError
+	if _, err := ss.Search(ctx, expensive, &zoekt.SearchOptions{}); !errors.As(err, &costErr) {
+		t.Fatalf("expensive search: got error %v, want a *zoekt.CostError", err)
+	}
+	if costErr.Cost.BruteForceDocs != 2 {
+		t.Errorf("got %d brute force docs, want 2", costErr.Cost.BruteForceDocs)
+	}
+
+	err = ss.StreamSearch(ctx, expensive, &zoekt.SearchOptions{}, stream.SenderFunc(func(*zoekt.SearchResult) {}))
+	if !errors.As(err, &costErr) {
+		t.Fatalf("expensive stream: got error %v, want a *zoekt.CostError", err)
+	}
+
+	// Downgraded searches still run.
+	ss.admission = AdmissionOptions{DowngradeBytes: 10}
+	sr, err := ss.Search(ctx, expensive, &zoekt.SearchOptions{})
+	if err != nil {
+		t.Fatalf("downgraded search: %v", err)
+	}
+	if len(sr.Files) != 2 {
+		t.Errorf("downgraded search: got %d files, want 2", len(sr.Files))
+	}
+}
+
+func TestDowngradeOptions(t *testing.T) {
+	opts := &zoekt.SearchOptions{
+		TotalMaxMatchCount: 5,
+		MaxDocDisplayCount: 100,
+	}
+	got := downgradeOptions(opts)
+
+	if got.ShardMaxMatchCount != 10000 {
+		t.Errorf("got ShardMaxMatchCount %d, want 10000", got.ShardMaxMatchCount)
+	}
+	if got.TotalMaxMatchCount != 1 {
+		t.Errorf("got TotalMaxMatchCount %d, want 1", got.TotalMaxMatchCount)
+	}
+	if got.MaxDocDisplayCount != 10 {
+		t.Errorf("got MaxDocDisplayCount %d, want 10", got.MaxDocDisplayCount)
+	}
+	if opts.ShardMaxMatchCount != 0 {
+		t.Error("downgradeOptions modified its argument")
+	}
+}
@@ -59,6 +59,9 @@ func (c *collectSender) Send(r *zoekt.SearchResult) {
 		}
 	}
 
+	c.aggregate.BackendErrors = append(c.aggregate.BackendErrors, r.BackendErrors...)
+	c.aggregate.IncompleteShards = append(c.aggregate.IncompleteShards, r.IncompleteShards...)
+
 	// The priority of our aggregate is the largest priority we collect.
 	if c.aggregate.Priority < r.Priority {
 		c.aggregate.Priority = r.Priority
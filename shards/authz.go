@@ -0,0 +1,108 @@
+package shards
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/sourcegraph/zoekt"
+	"github.com/sourcegraph/zoekt/authz"
+	"github.com/sourcegraph/zoekt/query"
+)
+
+// authorized is the part of the loaded shards a request may access.
+type authorized struct {
+	// shards contain at least one repository the request may access.
+	shards []*rankedShard
+
+	// partial maps the shards which also contain repositories the request may
+	// not access to the names of the repositories it may access.
+	partial map[*rankedShard]map[string]bool
+
+	// fingerprint identifies the accessible repositories, so results can be
+	// cached per set of repositories. It is a cryptographic hash, since a
+	// collision would serve results to a principal which may not see them.
+	fingerprint [sha256.Size]byte
+}
+
+// authorize applies the authorizer of ss to the principal of ctx. Without an
+// authorizer all shards are accessible.
+func (ss *shardedSearcher) authorize(ctx context.Context, shards []*rankedShard) (authorized, error) {
+	if ss.authorizer == nil {
+		return authorized{shards: shards}, nil
+	}
+
+	filter, err := ss.authorizer.Authorize(ctx, authz.PrincipalFromContext(ctx))
+	if err != nil {
+		return authorized{}, fmt.Errorf("authorizing search: %w", err)
+	}
+	if filter == nil {
+		return authorized{shards: shards}, nil
+	}
+
+	a := authorized{
+		shards:  make([]*rankedShard, 0, len(shards)),
+		partial: map[*rankedShard]map[string]bool{},
+	}
+	h := sha256.New()
+	for _, s := range shards {
+		any, all := false, true
+		allowed := map[string]bool{}
+		for _, repo := range s.repos {
+			if filter.Allow(repo) {
+				any = true
+				allowed[repo.Name] = true
+				h.Write([]byte(repo.Name))
+				h.Write([]byte{0})
+			} else {
+				all = false
+			}
+		}
+		if !any {
+			continue
+		}
+		a.shards = append(a.shards, s)
+		if !all {
+			a.partial[s] = allowed
+		}
+	}
+	h.Sum(a.fingerprint[:0])
+	return a, nil
+}
+
+// restrictShard returns q restricted to the repositories of s which may be
+// accessed. q is returned unchanged if all of them may be accessed.
+func (a authorized) restrictShard(s *rankedShard, q query.Q) query.Q {
+	allowed, ok := a.partial[s]
+	if !ok {
+		return q
+	}
+	return query.NewAnd(&query.RepoSet{Set: allowed}, q)
+}
+
+// incomplete describes s as incomplete for reason, listing only the
+// repositories which may be accessed.
+func (a authorized) incomplete(s *rankedShard, reason zoekt.IncompleteReason) zoekt.IncompleteShard {
+	allowed, ok := a.partial[s]
+	repos := make([]string, 0, len(s.repos))
+	for _, r := range s.repos {
+		if ok && !allowed[r.Name] {
+			continue
+		}
+		repos = append(repos, r.Name)
+	}
+	return zoekt.IncompleteShard{
+		Shard:  s.String(),
+		Repos:  repos,
+		Reason: reason,
+	}
+}
+
+// cacheKey scopes a result cache key to the accessible repositories. The
+// empty key, which disables caching, is returned unchanged.
+func (a authorized) cacheKey(key string) string {
+	if a.partial == nil || key == "" {
+		return key
+	}
+	return fmt.Sprintf("%x %s", a.fingerprint, key)
+}
@@ -0,0 +1,188 @@
+package shards
+
+import (
+	"context"
+	"errors"
+	"os"
+	"sort"
+	"testing"
+
+	"github.com/RoaringBitmap/roaring"
+	"github.com/google/go-cmp/cmp"
+	"github.com/grafana/regexp"
+
+	"github.com/sourcegraph/zoekt"
+	"github.com/sourcegraph/zoekt/authz"
+	"github.com/sourcegraph/zoekt/query"
+	"github.com/sourcegraph/zoekt/stream"
+)
+
+// writeCompoundTestShard writes a shard per repo and merges them into a
+// compound shard.
+func writeCompoundTestShard(t *testing.T, dir string, repos ...*zoekt.Repository) string {
+	t.Helper()
+
+	var files []zoekt.IndexFile
+	for _, repo := range repos {
+		f, err := os.Open(writeTestShard(t, t.TempDir(), repo, zoekt.Document{Name: "f", Content: []byte("needle " + repo.Name)}))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer f.Close()
+		indexFile, err := zoekt.NewIndexFile(f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer indexFile.Close()
+		files = append(files, indexFile)
+	}
+
+	tmpName, dstName, err := zoekt.Merge(dir, files...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Rename(tmpName, dstName); err != nil {
+		t.Fatal(err)
+	}
+	return dstName
+}
+
+func TestAuthorizer(t *testing.T) {
+	dir := t.TempDir()
+	repo := func(name string) *zoekt.Repository {
+		return &zoekt.Repository{Name: name, ID: hash(name)}
+	}
+
+	shards := map[string]zoekt.Searcher{}
+	for _, path := range []string{
+		writeTestShard(t, dir, repo("public"), zoekt.Document{Name: "f", Content: []byte("needle public")}),
+		writeTestShard(t, dir, repo("private"), zoekt.Document{Name: "f", Content: []byte("needle private")}),
+		writeCompoundTestShard(t, dir, repo("compound-public"), repo("compound-private")),
+	} {
+		s, err := loadShard(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		shards[path] = s
+	}
+
+	ss := newShardedSearcher(2)
+	ss.cache = newResultCache(1 << 20)
+	ss.replace(shards)
+	ss.markReady()
+
+	// Anonymous requests may only access public repositories, alice may
+	// access everything and bob only the private repository.
+	ss.authorizer = authz.AuthorizerFunc(func(ctx context.Context, p *authz.Principal) (*authz.Filter, error) {
+		switch {
+		case p == nil:
+			return &authz.Filter{RepoIDs: roaring.BitmapOf(hash("public"), hash("compound-public"))}, nil
+		case p.ID == "alice":
+			return nil, nil
+		case p.ID == "bob":
+			return &authz.Filter{Predicate: func(r *zoekt.Repository) bool { return r.Name == "private" }}, nil
+		}
+		return nil, errors.New("unknown principal")
+	})
+
+	anonymous := context.Background()
+	alice := authz.WithPrincipal(anonymous, &authz.Principal{ID: "alice"})
+	bob := authz.WithPrincipal(anonymous, &authz.Principal{ID: "bob"})
+	mallory := authz.WithPrincipal(anonymous, &authz.Principal{ID: "mallory"})
+
+	q := &query.Substring{Pattern: "needle"}
+
+	search := func(ctx context.Context) []string {
+		t.Helper()
+		sr, err := ss.Search(ctx, q, &zoekt.SearchOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var repos []string
+		for _, f := range sr.Files {
+			repos = append(repos, f.Repository)
+		}
+		sort.Strings(repos)
+		return repos
+	}
+
+	streamSearch := func(ctx context.Context) []string {
+		t.Helper()
+		var repos []string
+		err := ss.StreamSearch(ctx, q, &zoekt.SearchOptions{}, stream.SenderFunc(func(sr *zoekt.SearchResult) {
+			for _, f := range sr.Files {
+				repos = append(repos, f.Repository)
+			}
+		}))
+		if err != nil {
+			t.Fatal(err)
+		}
+		sort.Strings(repos)
+		return repos
+	}
+
+	list := func(ctx context.Context, q query.Q) []string {
+		t.Helper()
+		rl, err := ss.List(ctx, q, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var repos []string
+		for _, r := range rl.Repos {
+			repos = append(repos, r.Repository.Name)
+		}
+		sort.Strings(repos)
+		return repos
+	}
+
+	cases := []struct {
+		name string
+		ctx  context.Context
+		want []string
+	}{
+		{"anonymous", anonymous, []string{"compound-public", "public"}},
+		{"alice", alice, []string{"compound-private", "compound-public", "private", "public"}},
+		{"bob", bob, []string{"private"}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Search twice to check cached results are not shared between
+			// principals.
+			for i := 0; i < 2; i++ {
+				if d := cmp.Diff(tc.want, search(tc.ctx)); d != "" {
+					t.Errorf("Search mismatch (-want +got):\n%s", d)
+				}
+			}
+			if d := cmp.Diff(tc.want, streamSearch(tc.ctx)); d != "" {
+				t.Errorf("StreamSearch mismatch (-want +got):\n%s", d)
+			}
+			if d := cmp.Diff(tc.want, list(tc.ctx, &query.Const{Value: true})); d != "" {
+				t.Errorf("List mismatch (-want +got):\n%s", d)
+			}
+			if d := cmp.Diff(tc.want, list(tc.ctx, &query.Repo{Regexp: regexp.MustCompile("public|private")})); d != "" {
+				t.Errorf("List(repo:) mismatch (-want +got):\n%s", d)
+			}
+		})
+	}
+
+	if _, err := ss.Search(mallory, q, &zoekt.SearchOptions{}); err == nil {
+		t.Error("expected the error of the authorizer")
+	}
+}
+
+func TestAuthorizedIncomplete(t *testing.T) {
+	s := &rankedShard{
+		Searcher: &crashSearcher{},
+		repos:    []*zoekt.Repository{{Name: "public"}, {Name: "private"}},
+	}
+
+	a := authorized{shards: []*rankedShard{s}}
+	if d := cmp.Diff([]string{"public", "private"}, a.incomplete(s, zoekt.IncompleteCrashed).Repos); d != "" {
+		t.Errorf("mismatch (-want +got):\n%s", d)
+	}
+
+	a.partial = map[*rankedShard]map[string]bool{s: {"public": true}}
+	if d := cmp.Diff([]string{"public"}, a.incomplete(s, zoekt.IncompleteCrashed).Repos); d != "" {
+		t.Errorf("partial mismatch (-want +got):\n%s", d)
+	}
+}
@@ -0,0 +1,203 @@
+package shards
+
+import (
+	"container/list"
+	"fmt"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/sourcegraph/zoekt"
+	"github.com/sourcegraph/zoekt/query"
+	"github.com/sourcegraph/zoekt/stream"
+)
+
+var (
+	metricResultCacheHitsTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "zoekt_result_cache_hits_total",
+		Help: "The total number of searches answered from the result cache",
+	})
+	metricResultCacheMissesTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "zoekt_result_cache_misses_total",
+		Help: "The total number of searches not found in the result cache",
+	})
+	metricResultCacheBytes = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "zoekt_result_cache_bytes",
+		Help: "The estimated size of the results in the result cache, as reported by SearchResult.SizeBytes",
+	})
+)
+
+// resultCache is a cache of search results bounded by the size of the
+// results. Entries are only valid for the generation of loaded shards they
+// were computed on.
+type resultCache struct {
+	maxBytes uint64
+
+	mu         sync.Mutex
+	bytes      uint64
+	generation uint64
+	lru        *list.List // *resultCacheEntry, most recently used at the front
+	entries    map[string]*list.Element
+}
+
+type resultCacheEntry struct {
+	key  string
+	sr   *zoekt.SearchResult
+	size uint64
+}
+
+func newResultCache(maxBytes uint64) *resultCache {
+	return &resultCache{
+		maxBytes: maxBytes,
+		lru:      list.New(),
+		entries:  map[string]*list.Element{},
+	}
+}
+
+// resultCacheKey returns the cache key for searching q with opts, or "" if
+// the search can't be cached. Options which don't influence the result are
+// ignored.
+func resultCacheKey(q query.Q, opts *zoekt.SearchOptions) string {
+	// The String method abbreviates sets, eg. RepoIDs, so we use the protobuf
+	// encoding of q, which is lossless.
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(query.QToProto(query.Simplify(q)))
+	if err != nil {
+		return ""
+	}
+
+	o := *opts
+	o.Trace = false
+	o.SpanContext = nil
+	o.FlushWallTime = 0 // only changes when results are streamed
+	return fmt.Sprintf("%s %+v", b, o)
+}
+
+// get returns a copy of the cached result for key if it was computed on
+// generation.
+func (c *resultCache) get(generation uint64, key string) (*zoekt.SearchResult, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[key]
+	if !ok || c.generation != generation {
+		metricResultCacheMissesTotal.Inc()
+		return nil, false
+	}
+	metricResultCacheHitsTotal.Inc()
+	c.lru.MoveToFront(e)
+
+	cached := e.Value.(*resultCacheEntry).sr
+	sr := *cached
+	sr.Files = append([]zoekt.FileMatch(nil), cached.Files...)
+
+	// The work was done by an earlier search, so we only keep the stats which
+	// describe the result.
+	sr.Stats = zoekt.Stats{
+		FileCount:            cached.Stats.FileCount,
+		MatchCount:           cached.Stats.MatchCount,
+		ShardFilesConsidered: cached.Stats.ShardFilesConsidered,
+		FilesSkipped:         cached.Stats.FilesSkipped,
+		ShardsSkipped:        cached.Stats.ShardsSkipped,
+		FlushReason:          cached.Stats.FlushReason,
+		CacheHits:            1,
+	}
+	return &sr, true
+}
+
+// put adds sr, which was computed on generation, to the cache. sr must not
+// be modified afterwards.
+func (c *resultCache) put(generation uint64, key string, sr *zoekt.SearchResult) {
+	size := sr.SizeBytes() + uint64(len(key))
+	if size > c.maxBytes {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// The shards changed while we were searching.
+	if c.generation != generation {
+		return
+	}
+
+	if e, ok := c.entries[key]; ok {
+		c.removeElement(e)
+	}
+	c.entries[key] = c.lru.PushFront(&resultCacheEntry{key: key, sr: sr, size: size})
+	c.bytes += size
+
+	for c.bytes > c.maxBytes {
+		c.removeElement(c.lru.Back())
+	}
+	metricResultCacheBytes.Set(float64(c.bytes))
+}
+
+// invalidate drops all entries and only accepts results computed on
+// generation from now on.
+func (c *resultCache) invalidate(generation uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.generation = generation
+	c.lru.Init()
+	c.entries = map[string]*list.Element{}
+	c.bytes = 0
+	metricResultCacheBytes.Set(0)
+}
+
+func (c *resultCache) removeElement(e *list.Element) {
+	entry := c.lru.Remove(e).(*resultCacheEntry)
+	delete(c.entries, entry.key)
+	c.bytes -= entry.size
+}
+
+// resultCollector aggregates streamed results so they can be cached. It
+// gives up once the results grow beyond maxBytes.
+type resultCollector struct {
+	maxBytes uint64
+
+	mu      sync.Mutex
+	bytes   uint64
+	collect *collectSender // nil once we gave up
+}
+
+func newResultCollector(opts *zoekt.SearchOptions, maxBytes uint64) *resultCollector {
+	return &resultCollector{
+		maxBytes: maxBytes,
+		collect:  newCollectSender(opts),
+	}
+}
+
+// tee returns a sender which collects results before passing them on to
+// sender.
+func (c *resultCollector) tee(sender zoekt.Sender) zoekt.Sender {
+	return stream.SenderFunc(func(r *zoekt.SearchResult) {
+		c.mu.Lock()
+		if c.collect != nil {
+			c.bytes += r.SizeBytes()
+			if c.bytes > c.maxBytes {
+				c.collect = nil
+			} else {
+				c.collect.Send(r)
+			}
+		}
+		c.mu.Unlock()
+
+		sender.Send(r)
+	})
+}
+
+// done returns the aggregated result if it can be cached.
+func (c *resultCollector) done() (*zoekt.SearchResult, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.collect == nil {
+		return nil, false
+	}
+	sr, ok := c.collect.Done()
+	if !ok || sr.Stats.Crashes > 0 || len(sr.IncompleteShards) > 0 {
+		return nil, false
+	}
+	return sr, true
+}
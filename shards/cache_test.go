@@ -0,0 +1,149 @@
+package shards
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/sourcegraph/zoekt"
+	"github.com/sourcegraph/zoekt/query"
+	"github.com/sourcegraph/zoekt/stream"
+)
+
+func TestResultCache(t *testing.T) {
+	ss := newShardedSearcher(1)
+	ss.cache = newResultCache(1 << 20)
+	ss.markReady()
+
+	load := func(key, content string) {
+		repo := &zoekt.Repository{Name: key, ID: hash(key)}
+		ss.replace(map[string]zoekt.Searcher{
+			key: searcherForTest(t, testIndexBuilder(t, repo, zoekt.Document{Name: "f", Content: []byte(content)})),
+		})
+	}
+	load("repo1", "needle")
+
+	ctx := context.Background()
+	q := query.NewAnd(&query.Substring{Pattern: "needle"})
+	opts := &zoekt.SearchOptions{}
+
+	search := func() *zoekt.SearchResult {
+		t.Helper()
+		sr, err := ss.Search(ctx, q, opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return sr
+	}
+
+	if sr := search(); sr.Stats.CacheHits != 0 || len(sr.Files) != 1 {
+		t.Fatalf("first search: got %d cache hits and %d files, want 0 and 1", sr.Stats.CacheHits, len(sr.Files))
+	}
+
+	// The equivalent simplified query hits the cache.
+	q = &query.Substring{Pattern: "needle"}
+	if sr := search(); sr.Stats.CacheHits != 1 || len(sr.Files) != 1 {
+		t.Fatalf("second search: got %d cache hits and %d files, want 1 and 1", sr.Stats.CacheHits, len(sr.Files))
+	}
+
+	// Different options miss the cache.
+	opts = &zoekt.SearchOptions{Whole: true}
+	if sr := search(); sr.Stats.CacheHits != 0 {
+		t.Fatalf("got a cache hit for different options")
+	}
+
+	// Loading a shard invalidates the cache.
+	load("repo2", "needle haystack")
+	if sr := search(); sr.Stats.CacheHits != 0 || len(sr.Files) != 2 {
+		t.Fatalf("after replace: got %d cache hits and %d files, want 0 and 2", sr.Stats.CacheHits, len(sr.Files))
+	}
+
+	// Streamed results are cached as well.
+	streamSearch := func() (files, cacheHits int) {
+		t.Helper()
+		err := ss.StreamSearch(ctx, &query.Substring{Pattern: "haystack"}, opts, stream.SenderFunc(func(sr *zoekt.SearchResult) {
+			files += len(sr.Files)
+			cacheHits += sr.Stats.CacheHits
+		}))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return files, cacheHits
+	}
+	if files, hits := streamSearch(); files != 1 || hits != 0 {
+		t.Fatalf("first stream: got %d files and %d cache hits, want 1 and 0", files, hits)
+	}
+	if files, hits := streamSearch(); files != 1 || hits != 1 {
+		t.Fatalf("second stream: got %d files and %d cache hits, want 1 and 1", files, hits)
+	}
+}
+
+func TestResultCacheBytes(t *testing.T) {
+	sr := &zoekt.SearchResult{Files: []zoekt.FileMatch{{FileName: "a"}}}
+	size := sr.SizeBytes() + 1
+
+	c := newResultCache(2 * size)
+	c.put(0, "a", sr)
+	c.put(0, "b", sr)
+	c.put(0, "c", sr)
+
+	if _, ok := c.get(0, "a"); ok {
+		t.Error("the least recently used entry should have been evicted")
+	}
+	for _, key := range []string{"b", "c"} {
+		if _, ok := c.get(0, key); !ok {
+			t.Errorf("missing entry %q", key)
+		}
+	}
+	if c.bytes != 2*size {
+		t.Errorf("got %d bytes, want %d", c.bytes, 2*size)
+	}
+
+	// Results computed on an old generation are not cached.
+	c.invalidate(1)
+	c.put(0, "a", sr)
+	if _, ok := c.get(1, "a"); ok {
+		t.Error("cached a result of an old generation")
+	}
+}
+
+func TestResultCacheKey(t *testing.T) {
+	opts := &zoekt.SearchOptions{}
+	names := func(prefix string) []string {
+		var s []string
+		for i := 0; i < 10; i++ {
+			s = append(s, fmt.Sprintf("%s%d", prefix, i))
+		}
+		return s
+	}
+
+	for _, tc := range []struct {
+		name string
+		a, b query.Q
+	}{{
+		name: "RepoIDs",
+		a:    query.NewRepoIDs(1, 2, 3),
+		b:    query.NewRepoIDs(4, 5, 6),
+	}, {
+		name: "RepoSet",
+		a:    query.NewRepoSet(names("a")...),
+		b:    query.NewRepoSet(names("b")...),
+	}, {
+		name: "FileNameSet",
+		a:    query.NewFileNameSet(names("a")...),
+		b:    query.NewFileNameSet(names("b")...),
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.a.String() != tc.b.String() {
+				t.Fatalf("test queries should have the same String: %s and %s", tc.a, tc.b)
+			}
+			a, b := resultCacheKey(tc.a, opts), resultCacheKey(tc.b, opts)
+			if a == "" || a == b {
+				t.Errorf("got keys %q and %q, want distinct keys", a, b)
+			}
+			if again := resultCacheKey(tc.a, opts); again != a {
+				t.Errorf("got keys %q and %q for the same query", a, again)
+			}
+		})
+	}
+}
@@ -0,0 +1,269 @@
+package shards
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/sourcegraph/zoekt"
+	"github.com/sourcegraph/zoekt/query"
+	"github.com/sourcegraph/zoekt/stream"
+	"github.com/sourcegraph/zoekt/trace"
+)
+
+var metricFederatedBackendErrorsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "zoekt_federated_backend_errors_total",
+	Help: "The total number of federated requests to a backend which failed or timed out",
+}, []string{"backend", "reason"})
+
+// Backend is a remote searcher queried by a federated searcher.
+type Backend struct {
+	// Name identifies the backend in SearchResult.BackendErrors, usually its
+	// address.
+	Name string
+
+	zoekt.Streamer
+}
+
+type federatedSearcher struct {
+	backends []Backend
+	timeout  time.Duration
+}
+
+// NewFederatedSearcher returns a searcher which sends every request to all
+// backends and merges their results with the same ranking as a local sharded
+// searcher. SearchOptions.TotalMaxMatchCount applies to the merged results.
+//
+// A backend which fails, or does not finish within timeout, is reported in
+// SearchResult.BackendErrors and counted in Stats.Crashes instead of failing
+// the search. A timeout of 0 means backends are not timed out.
+func NewFederatedSearcher(backends []Backend, timeout time.Duration) zoekt.Streamer {
+	return &federatedSearcher{
+		backends: backends,
+		timeout:  timeout,
+	}
+}
+
+func (fs *federatedSearcher) Search(ctx context.Context, q query.Q, opts *zoekt.SearchOptions) (*zoekt.SearchResult, error) {
+	tr, ctx := trace.New(ctx, "federatedSearcher.Search", "")
+	defer tr.Finish()
+
+	start := time.Now()
+	collectSender := newCollectSender(opts)
+	fs.search(ctx, q, opts, collectSender)
+
+	aggregate, ok := collectSender.Done()
+	if !ok {
+		aggregate = &zoekt.SearchResult{
+			RepoURLs:      map[string]string{},
+			LineFragments: map[string]string{},
+		}
+	}
+	aggregate.Stats.Duration = time.Since(start)
+	return aggregate, nil
+}
+
+func (fs *federatedSearcher) StreamSearch(ctx context.Context, q query.Q, opts *zoekt.SearchOptions, sender zoekt.Sender) error {
+	tr, ctx := trace.New(ctx, "federatedSearcher.StreamSearch", "")
+	defer tr.Finish()
+
+	// Same stack as shardedSearcher.StreamSearch, except that results from
+	// backends don't reference mmaped data, so there is nothing to copy.
+	if truncator, hasLimits := zoekt.NewDisplayTruncator(opts); hasLimits {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithCancel(ctx)
+		defer cancel()
+		sender = limitSender(cancel, sender, truncator)
+	}
+
+	sender, flush := newFlushCollectSender(opts, sender)
+	fs.search(ctx, q, opts, sender)
+	flush()
+
+	return nil
+}
+
+// search streams q to all backends and sends their results to sender. Calls
+// to sender are serialized.
+func (fs *federatedSearcher) search(ctx context.Context, q query.Q, opts *zoekt.SearchOptions, sender zoekt.Sender) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		mu sync.Mutex
+
+		// tracked so we can stop when we hit TotalMaxMatchCount
+		totalMatchCount int
+
+		// the latest MaxPendingPriority reported by each backend
+		pending = make([]float64, len(fs.backends))
+	)
+	for i := range pending {
+		pending[i] = math.Inf(1)
+	}
+
+	send := func(i int, r *zoekt.SearchResult) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		totalMatchCount += r.Stats.MatchCount
+		if opts.TotalMaxMatchCount > 0 && totalMatchCount > opts.TotalMaxMatchCount {
+			cancel()
+		}
+
+		// Each backend reports monotonically decreasing priorities, so the
+		// maximum over all backends is monotonically decreasing as well.
+		pending[i] = r.MaxPendingPriority
+		r.MaxPendingPriority = math.Inf(-1)
+		for _, p := range pending {
+			r.MaxPendingPriority = math.Max(r.MaxPendingPriority, p)
+		}
+
+		sender.Send(r)
+	}
+
+	var wg sync.WaitGroup
+	for i, b := range fs.backends {
+		wg.Add(1)
+		go func(i int, b Backend) {
+			defer wg.Done()
+
+			bctx := ctx
+			if fs.timeout > 0 {
+				var bcancel context.CancelFunc
+				bctx, bcancel = context.WithTimeout(ctx, fs.timeout)
+				defer bcancel()
+			}
+
+			err := b.StreamSearch(bctx, q, opts, stream.SenderFunc(func(r *zoekt.SearchResult) {
+				send(i, r)
+			}))
+
+			done := &zoekt.SearchResult{
+				Progress: zoekt.Progress{
+					Priority:           math.Inf(-1),
+					MaxPendingPriority: math.Inf(-1),
+				},
+			}
+
+			// If ctx is done we stopped the search on purpose, either because
+			// we have enough matches or because the caller went away.
+			if err != nil && ctx.Err() == nil {
+				timeout := errors.Is(bctx.Err(), context.DeadlineExceeded)
+				reason := "error"
+				if timeout {
+					reason = "timeout"
+				}
+				metricFederatedBackendErrorsTotal.WithLabelValues(b.Name, reason).Inc()
+
+				done.Stats.Crashes = 1
+				done.BackendErrors = []zoekt.BackendError{{
+					Backend: b.Name,
+					Error:   err.Error(),
+					Timeout: timeout,
+				}}
+			}
+
+			send(i, done)
+		}(i, b)
+	}
+	wg.Wait()
+}
+
+func (fs *federatedSearcher) List(ctx context.Context, q query.Q, opts *zoekt.ListOptions) (*zoekt.RepoList, error) {
+	tr, ctx := trace.New(ctx, "federatedSearcher.List", "")
+	defer tr.Finish()
+
+	type listResult struct {
+		rl  *zoekt.RepoList
+		err error
+	}
+
+	results := make([]listResult, len(fs.backends))
+	var wg sync.WaitGroup
+	for i, b := range fs.backends {
+		wg.Add(1)
+		go func(i int, b Backend) {
+			defer wg.Done()
+
+			bctx := ctx
+			if fs.timeout > 0 {
+				var bcancel context.CancelFunc
+				bctx, bcancel = context.WithTimeout(ctx, fs.timeout)
+				defer bcancel()
+			}
+
+			rl, err := b.List(bctx, q, opts)
+			results[i] = listResult{rl: rl, err: err}
+		}(i, b)
+	}
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	agg := zoekt.RepoList{
+		Minimal:  map[uint32]*zoekt.MinimalRepoListEntry{},
+		ReposMap: zoekt.ReposMap{},
+	}
+
+	uniq := map[string]*zoekt.RepoListEntry{}
+
+	for i, r := range results {
+		if r.err != nil {
+			// A failed backend is reported as a crash, like a shard which
+			// failed to list.
+			tr.LazyPrintf("backend %s: %v", fs.backends[i].Name, r.err)
+			agg.Crashes++
+			continue
+		}
+
+		agg.Crashes += r.rl.Crashes
+		agg.Stats.Add(&r.rl.Stats)
+
+		for _, r := range r.rl.Repos {
+			prev, ok := uniq[r.Repository.Name]
+			if !ok {
+				cp := *r // We need to copy because we mutate r.Stats when merging duplicates
+				uniq[r.Repository.Name] = &cp
+			} else {
+				prev.Stats.Add(&r.Stats)
+			}
+		}
+
+		for id, r := range r.rl.Minimal {
+			if _, ok := agg.Minimal[id]; !ok {
+				agg.Minimal[id] = r
+			}
+		}
+
+		for id, r := range r.rl.ReposMap {
+			agg.ReposMap[id] = r
+		}
+	}
+
+	agg.Repos = make([]*zoekt.RepoListEntry, 0, len(uniq))
+	for _, r := range uniq {
+		agg.Repos = append(agg.Repos, r)
+	}
+
+	agg.Stats.Repos = len(uniq) + len(agg.Minimal) + len(agg.ReposMap)
+
+	return &agg, nil
+}
+
+func (fs *federatedSearcher) Close() {
+	for _, b := range fs.backends {
+		b.Close()
+	}
+}
+
+func (fs *federatedSearcher) String() string {
+	return "federatedSearcher"
+}
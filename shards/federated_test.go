@@ -0,0 +1,149 @@
+package shards
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/zoekt"
+	"github.com/sourcegraph/zoekt/query"
+)
+
+// fakeBackend streams one result per file, each with a single match.
+type fakeBackend struct {
+	files []string
+	err   error
+	hang  bool
+}
+
+func (b *fakeBackend) Search(ctx context.Context, q query.Q, opts *zoekt.SearchOptions) (*zoekt.SearchResult, error) {
+	panic("not implemented")
+}
+
+func (b *fakeBackend) StreamSearch(ctx context.Context, q query.Q, opts *zoekt.SearchOptions, sender zoekt.Sender) error {
+	for i, name := range b.files {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		sender.Send(&zoekt.SearchResult{
+			Stats: zoekt.Stats{MatchCount: 1, FileCount: 1},
+			Files: []zoekt.FileMatch{{FileName: name, Repository: "repo"}},
+			Progress: zoekt.Progress{
+				Priority:           float64(len(b.files) - i),
+				MaxPendingPriority: float64(len(b.files) - i - 1),
+			},
+		})
+	}
+	if b.hang {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+	return b.err
+}
+
+func (b *fakeBackend) List(ctx context.Context, q query.Q, opts *zoekt.ListOptions) (*zoekt.RepoList, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	if b.hang {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	rl := &zoekt.RepoList{}
+	for _, name := range b.files {
+		rl.Repos = append(rl.Repos, &zoekt.RepoListEntry{Repository: zoekt.Repository{Name: name}})
+	}
+	return rl, nil
+}
+
+func (*fakeBackend) Close() {}
+
+func (*fakeBackend) String() string { return "fakeBackend" }
+
+func TestFederatedSearcher(t *testing.T) {
+	fs := NewFederatedSearcher([]Backend{
+		{Name: "a", Streamer: &fakeBackend{files: []string{"a1", "a2"}}},
+		{Name: "b", Streamer: &fakeBackend{files: []string{"b1"}}},
+		{Name: "broken", Streamer: &fakeBackend{err: errors.New("boom")}},
+		{Name: "slow", Streamer: &fakeBackend{hang: true}},
+	}, 50*time.Millisecond)
+
+	sr, err := fs.Search(context.Background(), &query.Substring{Pattern: "x"}, &zoekt.SearchOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var files []string
+	for _, f := range sr.Files {
+		files = append(files, f.FileName)
+	}
+	sort.Strings(files)
+	if d := cmp.Diff([]string{"a1", "a2", "b1"}, files); d != "" {
+		t.Errorf("files mismatch (-want +got):\n%s", d)
+	}
+
+	want := []zoekt.BackendError{
+		{Backend: "broken", Error: "boom"},
+		{Backend: "slow", Error: context.DeadlineExceeded.Error(), Timeout: true},
+	}
+	sort.Slice(sr.BackendErrors, func(i, j int) bool {
+		return sr.BackendErrors[i].Backend < sr.BackendErrors[j].Backend
+	})
+	if d := cmp.Diff(want, sr.BackendErrors); d != "" {
+		t.Errorf("backend errors mismatch (-want +got):\n%s", d)
+	}
+	if sr.Stats.Crashes != 2 {
+		t.Errorf("got %d crashes, want 2", sr.Stats.Crashes)
+	}
+	if sr.MaxPendingPriority != math.Inf(-1) {
+		t.Errorf("got MaxPendingPriority %v, want -Inf", sr.MaxPendingPriority)
+	}
+
+	rl, err := fs.List(context.Background(), &query.Const{Value: true}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rl.Repos) != 3 || rl.Crashes != 2 {
+		t.Errorf("got %d repos and %d crashes, want 3 and 2", len(rl.Repos), rl.Crashes)
+	}
+}
+
+func TestFederatedSearcherTotalMaxMatchCount(t *testing.T) {
+	var backends []Backend
+	for i := 0; i < 3; i++ {
+		var files []string
+		for j := 0; j < 100; j++ {
+			files = append(files, fmt.Sprintf("%d-%d", i, j))
+		}
+		// Backends hang after sending their results, so the search only
+		// finishes early if the limit cancels it.
+		backends = append(backends, Backend{
+			Name:     fmt.Sprint(i),
+			Streamer: &fakeBackend{files: files, hang: true},
+		})
+	}
+	fs := NewFederatedSearcher(backends, 0)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	sr, err := fs.Search(ctx, &query.Substring{Pattern: "x"}, &zoekt.SearchOptions{TotalMaxMatchCount: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ctx.Err() != nil {
+		t.Fatal("search was not stopped by TotalMaxMatchCount")
+	}
+	if len(sr.BackendErrors) != 0 {
+		t.Errorf("stopping the search should not be reported as backend errors: %v", sr.BackendErrors)
+	}
+	if len(sr.Files) == 300 {
+		t.Errorf("got all files, want the search to stop early")
+	}
+}
@@ -0,0 +1,326 @@
+package shards
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/sourcegraph/zoekt"
+	"github.com/sourcegraph/zoekt/query"
+)
+
+var (
+	metricShardCacheHitsTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "zoekt_shard_cache_hits_total",
+		Help: "The total number of shard accesses which found the shard resident",
+	})
+	metricShardCacheMissesTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "zoekt_shard_cache_misses_total",
+		Help: "The total number of shard accesses which had to load the shard from disk",
+	})
+	metricShardCacheEvictionsTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "zoekt_shard_cache_evictions_total",
+		Help: "The total number of shards evicted to stay within the memory budget",
+	})
+	metricShardCacheResidentBytes = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "zoekt_shard_cache_resident_bytes",
+		Help: "The index memory used by resident shards, as reported by RepoStats.IndexBytes",
+	})
+	metricShardCacheResident = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "zoekt_shard_cache_resident",
+		Help: "The number of resident shards",
+	})
+)
+
+var errShardClosed = errors.New("shard is closed")
+
+// shardCache keeps the most recently used lazyShards loaded while the sum of
+// their index memory stays within budget.
+type shardCache struct {
+	budget int64
+
+	mu    sync.Mutex
+	used  int64
+	lru   *list.List // *lazyShard, most recently used at the front
+	elems map[*lazyShard]*list.Element
+}
+
+func newShardCache(budget int64) *shardCache {
+	return &shardCache{
+		budget: budget,
+		lru:    list.New(),
+		elems:  map[*lazyShard]*list.Element{},
+	}
+}
+
+// touch marks s as the most recently used shard and evicts the least recently
+// used shards which are not in use until we are within budget again. s itself
+// is never evicted, so a single shard larger than the budget still works.
+func (c *shardCache) touch(s *lazyShard) {
+	c.mu.Lock()
+	if e, ok := c.elems[s]; ok {
+		c.lru.MoveToFront(e)
+	} else {
+		c.elems[s] = c.lru.PushFront(s)
+		c.used += s.size
+	}
+
+	var victims []*lazyShard
+	used := c.used
+	for e := c.lru.Back(); e != nil && used > c.budget; e = e.Prev() {
+		v := e.Value.(*lazyShard)
+		if v == s {
+			continue
+		}
+		victims = append(victims, v)
+		used -= v.size
+	}
+	c.updateMetrics()
+	c.mu.Unlock()
+
+	for _, v := range victims {
+		if v.evict() {
+			metricShardCacheEvictionsTotal.Inc()
+		}
+	}
+}
+
+// remove must be called with s.mu held.
+func (c *shardCache) remove(s *lazyShard) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.elems[s]; ok {
+		c.lru.Remove(e)
+		delete(c.elems, s)
+		c.used -= s.size
+	}
+	c.updateMetrics()
+}
+
+func (c *shardCache) updateMetrics() {
+	metricShardCacheResidentBytes.Set(float64(c.used))
+	metricShardCacheResident.Set(float64(c.lru.Len()))
+}
+
+// lazyShard is a zoekt.Searcher which only keeps the repository metadata of
+// a shard in memory. The index itself is loaded on demand and may be evicted
+// by its shardCache when it is not in use.
+type lazyShard struct {
+	path  string
+	cache *shardCache
+
+	// repos is the result of List(true) on the shard, which is enough to
+	// rank the shard and to answer most List calls without loading it.
+	repos []*zoekt.RepoListEntry
+
+	// size is the memory use of the loaded shard.
+	size int64
+
+	mu       sync.Mutex // protects the fields below
+	searcher zoekt.Searcher
+	refs     int
+	closed   bool
+}
+
+// newLazyShard loads the shard at path to read its metadata. The loaded shard
+// is added to cache, so it stays resident until it is evicted.
+func newLazyShard(path string, cache *shardCache) (*lazyShard, error) {
+	searcher, err := loadShard(path)
+	if err != nil {
+		return nil, err
+	}
+
+	rl, err := searcher.List(context.Background(), &query.Const{Value: true}, nil)
+	if err != nil {
+		searcher.Close()
+		return nil, fmt.Errorf("List(%s): %w", path, err)
+	}
+
+	s := &lazyShard{
+		path:     path,
+		cache:    cache,
+		searcher: searcher,
+	}
+	for _, r := range rl.Repos {
+		cp := *r
+		s.repos = append(s.repos, &cp)
+		s.size += r.Stats.IndexBytes
+	}
+
+	cache.touch(s)
+	return s, nil
+}
+
+// acquire returns the loaded shard, loading it if necessary. It must be
+// paired with a call to release, until which the shard won't be evicted.
+func (s *lazyShard) acquire() (zoekt.Searcher, error) {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return nil, errShardClosed
+	}
+	if s.searcher == nil {
+		metricShardCacheMissesTotal.Inc()
+		searcher, err := loadShard(s.path)
+		if err != nil {
+			s.mu.Unlock()
+			metricShardsLoadFailedTotal.Inc()
+			return nil, err
+		}
+		s.searcher = searcher
+	} else {
+		metricShardCacheHitsTotal.Inc()
+	}
+	s.refs++
+	searcher := s.searcher
+	s.mu.Unlock()
+
+	s.cache.touch(s)
+	return searcher, nil
+}
+
+func (s *lazyShard) release() {
+	s.mu.Lock()
+	s.refs--
+	s.mu.Unlock()
+}
+
+// evict closes the loaded shard if it isn't in use.
+func (s *lazyShard) evict() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.refs > 0 || s.searcher == nil {
+		return false
+	}
+	s.searcher.Close()
+	s.searcher = nil
+	s.cache.remove(s)
+	return true
+}
+
+func (s *lazyShard) Search(ctx context.Context, q query.Q, opts *zoekt.SearchOptions) (*zoekt.SearchResult, error) {
+	searcher, err := s.acquire()
+	if err != nil {
+		return nil, err
+	}
+	defer s.release()
+
+	sr, err := searcher.Search(ctx, q, opts)
+	if sr != nil {
+		// The results reference the mmap of the shard, which may be unmapped
+		// as soon as we release it.
+		copyFiles(sr)
+	}
+	return sr, err
+}
+
+// EstimateCost implements zoekt.CostEstimator. The estimate needs the ngram
+// index, but admission control must not load shards or disturb the working
+// set, so a shard which is not resident costs nothing.
+func (s *lazyShard) EstimateCost(q query.Q) zoekt.Cost {
+	searcher, ok := s.acquireResident()
+	if !ok {
+		return zoekt.Cost{}
+	}
+	defer s.release()
+
+	if ce, ok := searcher.(zoekt.CostEstimator); ok {
+		return ce.EstimateCost(q)
+	}
+	return zoekt.Cost{}
+}
+
+// acquireResident is like acquire, but returns false instead of loading the
+// shard. It doesn't count as a use of the shard for eviction.
+func (s *lazyShard) acquireResident() (zoekt.Searcher, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed || s.searcher == nil {
+		return nil, false
+	}
+	s.refs++
+	return s.searcher, true
+}
+
+func (s *lazyShard) List(ctx context.Context, q query.Q, opts *zoekt.ListOptions) (*zoekt.RepoList, error) {
+	if c, ok := query.Simplify(q).(*query.Const); ok {
+		if !c.Value {
+			return &zoekt.RepoList{}, nil
+		}
+		return s.listResident(opts)
+	}
+
+	searcher, err := s.acquire()
+	if err != nil {
+		return nil, err
+	}
+	defer s.release()
+
+	return searcher.List(ctx, q, opts)
+}
+
+// listResident is List for a query matching all repositories, answered from
+// the resident metadata.
+func (s *lazyShard) listResident(opts *zoekt.ListOptions) (*zoekt.RepoList, error) {
+	field, err := opts.GetField()
+	if err != nil {
+		return nil, err
+	}
+
+	var l zoekt.RepoList
+	switch field {
+	case zoekt.RepoListFieldRepos:
+		l.Repos = make([]*zoekt.RepoListEntry, 0, len(s.repos))
+	case zoekt.RepoListFieldMinimal:
+		l.Minimal = make(map[uint32]*zoekt.MinimalRepoListEntry, len(s.repos))
+	case zoekt.RepoListFieldReposMap:
+		l.ReposMap = make(zoekt.ReposMap, len(s.repos))
+	}
+
+	for _, rle := range s.repos {
+		l.Stats.Add(&rle.Stats)
+
+		// Backwards compat for when ID is missing
+		if rle.Repository.ID == 0 {
+			l.Repos = append(l.Repos, rle)
+			continue
+		}
+
+		minimal := zoekt.MinimalRepoListEntry{
+			HasSymbols:    rle.Repository.HasSymbols,
+			Branches:      rle.Repository.Branches,
+			IndexTimeUnix: rle.IndexMetadata.IndexTime.Unix(),
+		}
+		switch field {
+		case zoekt.RepoListFieldRepos:
+			l.Repos = append(l.Repos, rle)
+		case zoekt.RepoListFieldMinimal:
+			l.Minimal[rle.Repository.ID] = &minimal
+		case zoekt.RepoListFieldReposMap:
+			l.ReposMap[rle.Repository.ID] = minimal
+		}
+	}
+
+	l.Stats.Repos = len(l.Repos) + len(l.Minimal) + len(l.ReposMap)
+	return &l, nil
+}
+
+func (s *lazyShard) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.closed = true
+	if s.searcher != nil {
+		s.searcher.Close()
+		s.searcher = nil
+		s.cache.remove(s)
+	}
+}
+
+func (s *lazyShard) String() string {
+	return fmt.Sprintf("lazyShard(%s)", s.path)
+}
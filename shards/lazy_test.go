@@ -0,0 +1,143 @@
+package shards
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/grafana/regexp"
+
+	"github.com/sourcegraph/zoekt"
+	"github.com/sourcegraph/zoekt/query"
+)
+
+func writeTestShard(t *testing.T, dir string, repo *zoekt.Repository, docs ...zoekt.Document) string {
+	t.Helper()
+
+	b := testIndexBuilder(t, repo, docs...)
+	path := filepath.Join(dir, repo.Name+".zoekt")
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if err := b.Write(f); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLazyShardEviction(t *testing.T) {
+	dir := t.TempDir()
+
+	// A budget of 1 byte means only the most recently used shard stays
+	// resident.
+	cache := newShardCache(1)
+
+	var shards []*lazyShard
+	for i := 0; i < 3; i++ {
+		repo := &zoekt.Repository{Name: fmt.Sprintf("repo%d", i), ID: uint32(i + 1)}
+		path := writeTestShard(t, dir, repo, zoekt.Document{
+			Name:    "f.txt",
+			Content: []byte(fmt.Sprintf("needle %d", i)),
+		})
+		s, err := newLazyShard(path, cache)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer s.Close()
+		shards = append(shards, s)
+	}
+
+	resident := func() (n int) {
+		for _, s := range shards {
+			s.mu.Lock()
+			if s.searcher != nil {
+				n++
+			}
+			s.mu.Unlock()
+		}
+		return n
+	}
+
+	if got := resident(); got != 1 {
+		t.Fatalf("got %d resident shards, want 1", got)
+	}
+
+	// Admission control doesn't load shards to estimate their cost.
+	if c := shards[0].EstimateCost(&query.Substring{Pattern: "needle"}); c != (zoekt.Cost{}) {
+		t.Errorf("got cost %v for a shard which is not resident", c)
+	}
+	if shards[0].searcher != nil {
+		t.Fatal("EstimateCost loaded the shard")
+	}
+	if c := shards[2].EstimateCost(&query.Substring{Pattern: "needle"}); c.Docs == 0 {
+		t.Errorf("got cost %v for a resident shard, want an estimate", c)
+	}
+
+	// List(true) is answered from the metadata without loading the shard.
+	rl, err := shards[0].List(context.Background(), &query.Const{Value: true}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rl.Repos) != 1 || rl.Repos[0].Repository.Name != "repo0" {
+		t.Fatalf("unexpected repos %v", rl.Repos)
+	}
+	if shards[0].searcher != nil {
+		t.Fatal("List(true) loaded the shard")
+	}
+
+	sr, err := shards[0].Search(context.Background(), &query.Substring{Pattern: "needle"}, &zoekt.SearchOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sr.Files) != 1 || sr.Files[0].Repository != "repo0" {
+		t.Fatalf("unexpected search result %v", sr.Files)
+	}
+	if shards[0].searcher == nil || resident() != 1 {
+		t.Fatalf("want only the searched shard to be resident")
+	}
+
+	// With a budget large enough for all shards nothing is evicted.
+	cache.budget = 1 << 30
+	for _, s := range shards {
+		if _, err := s.Search(context.Background(), &query.Substring{Pattern: "needle"}, &zoekt.SearchOptions{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got := resident(); got != 3 {
+		t.Fatalf("got %d resident shards, want 3", got)
+	}
+}
+
+func TestLazyShardedSearcher(t *testing.T) {
+	dir := t.TempDir()
+	for i := 0; i < 3; i++ {
+		repo := &zoekt.Repository{Name: fmt.Sprintf("repo%d", i), ID: uint32(i + 1)}
+		writeTestShard(t, dir, repo, zoekt.Document{Name: "f.txt", Content: []byte("needle")})
+	}
+
+	ss, err := NewDirectorySearcherWithOptions(dir, DirectorySearcherOptions{WaitUntilReady: true, ShardMemoryBudget: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ss.Close()
+
+	sr, err := ss.Search(context.Background(), &query.Substring{Pattern: "needle"}, &zoekt.SearchOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sr.Files) != 3 {
+		t.Fatalf("got %d files, want 3", len(sr.Files))
+	}
+
+	rl, err := ss.List(context.Background(), &query.Repo{Regexp: regexp.MustCompile("repo1")}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rl.Repos) != 1 || rl.Repos[0].Repository.Name != "repo1" {
+		t.Fatalf("unexpected repos %v", rl.Repos)
+	}
+}
@@ -6,11 +6,14 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	"golang.org/x/sync/semaphore"
+
+	"github.com/sourcegraph/zoekt/internal/clientid"
 )
 
 // Note: This is a Sourcegraph specific addition to allow long running queries
@@ -22,6 +25,11 @@ type scheduler interface {
 	// request). See process documentation. It will only return an error if the
 	// context expires.
 	Acquire(ctx context.Context) (*process, error)
+
+	// AcquireBatch is like Acquire, but the process starts at the priority
+	// of a long running search. It is used for searches we know upfront to be
+	// expensive.
+	AcquireBatch(ctx context.Context) (*process, error)
 }
 
 // The ZOEKTSCHED environment variable controls variables within the
@@ -42,6 +50,19 @@ type scheduler interface {
 // customers/sourcegraph.com in a permanent manor (only temporary).
 var zoektSched = parseTuneables(os.Getenv("ZOEKTSCHED"))
 
+// The ZOEKTSCHEDCLIENTS environment variable configures how the
+// multiScheduler shares capacity between clients, identified by
+// clientid.FromContext. It is a comma-separated list of class=weight[/max]
+// entries. A client whose identity matches a class name is in that class,
+// all other clients are in the "default" class. Waiting clients are served
+// in proportion to the weight of their class, and a single client may run at
+// most max searches concurrently (0 means no limit). For example
+//
+//	ZOEKTSCHEDCLIENTS=ui=4,dashboards=1/2,default=1
+//
+// The default class has weight 1 and no limit unless configured.
+var zoektSchedClients = parseClientClasses(os.Getenv("ZOEKTSCHEDCLIENTS"))
+
 // newScheduler returns a scheduler for use in searches. It will return a
 // multiScheduler unless that has been disabled with the environment variable
 // SCHED_DISABLE. If so it will an equivalent scheduler as upstream zoekt.
@@ -120,8 +141,8 @@ func newMultiScheduler(capacity int64) *multiScheduler {
 	}
 
 	return &multiScheduler{
-		semInteractive: newSema(capacity, "interactive"),
-		semBatch:       newSema(batchCap, "batch"),
+		semInteractive: newSema(capacity, "interactive", zoektSchedClients),
+		semBatch:       newSema(batchCap, "batch", zoektSchedClients),
 
 		interactiveDuration: time.Duration(interactiveseconds) * time.Second,
 	}
@@ -137,22 +158,23 @@ func (s *multiScheduler) Acquire(ctx context.Context) (*process, error) {
 	// the nil value will prevent us from releasing twice.
 
 	sem := s.semInteractive
+	client := clientid.FromContext(ctx)
 
-	if err := sem.Acquire(ctx); err != nil {
+	if err := sem.Acquire(ctx, client); err != nil {
 		return nil, err
 	}
 
 	return &process{
 		releaseFunc: func() {
 			if sem != nil {
-				sem.Release()
+				sem.Release(client)
 				sem = nil
 			}
 		},
 		yieldTimer: newDeadlineTimer(time.Now().Add(s.interactiveDuration)),
 		yieldFunc: func(ctx context.Context) error {
 			if sem != nil {
-				sem.Release()
+				sem.Release(client)
 				sem = nil
 			}
 
@@ -160,7 +182,7 @@ func (s *multiScheduler) Acquire(ctx context.Context) (*process, error) {
 			// clean it up. If this fails we assume the process will stop running
 			// (ctx has expired).
 			semNext := s.semBatch
-			if err := semNext.Acquire(ctx); err != nil {
+			if err := semNext.Acquire(ctx, client); err != nil {
 				return err
 			}
 
@@ -170,6 +192,19 @@ func (s *multiScheduler) Acquire(ctx context.Context) (*process, error) {
 	}, nil
 }
 
+// AcquireBatch implements scheduler.AcquireBatch.
+func (s *multiScheduler) AcquireBatch(ctx context.Context) (*process, error) {
+	client := clientid.FromContext(ctx)
+	if err := s.semBatch.Acquire(ctx, client); err != nil {
+		return nil, err
+	}
+	return &process{
+		releaseFunc: func() {
+			s.semBatch.Release(client)
+		},
+	}, nil
+}
+
 // semaphoreScheduler shares a single semaphore for all searches. An exclusive
 // process acquires the full semaphore. This is equivalent to how concurrency
 // is managed in upstream. It exists as a fallback while we test
@@ -184,6 +219,12 @@ func (s *semaphoreScheduler) Acquire(ctx context.Context) (*process, error) {
 	return s.acquire(ctx, 1)
 }
 
+// AcquireBatch implements scheduler.AcquireBatch. There is no batch
+// priority, so it is the same as Acquire.
+func (s *semaphoreScheduler) AcquireBatch(ctx context.Context) (*process, error) {
+	return s.acquire(ctx, 1)
+}
+
 // Exclusive implements scheduler.Exclusive.
 func (s *semaphoreScheduler) Exclusive() *process {
 	// Won't error since context.Background won't expire.
@@ -315,6 +356,67 @@ func parseTuneables(v string) map[string]int {
 	return m
 }
 
+// clientClass configures how the clients in a class share a sema.
+type clientClass struct {
+	name string
+
+	// weight is the share of the capacity of waiting clients of this class
+	// relative to other classes.
+	weight float64
+
+	// max is the maximum number of processes a single client of this class
+	// may run concurrently. 0 means no limit.
+	max int64
+}
+
+// defaultClientClass is the class of clients which don't match any
+// configured class.
+const defaultClientClass = "default"
+
+type clientClasses map[string]*clientClass
+
+// get returns the class of client.
+func (cc clientClasses) get(client string) *clientClass {
+	if c, ok := cc[client]; ok {
+		return c
+	}
+	return cc[defaultClientClass]
+}
+
+// parseClientClasses parses a comma separated string of class=weight[/max]
+// pairs, see ZOEKTSCHEDCLIENTS. Invalid entries are logged and ignored.
+func parseClientClasses(v string) clientClasses {
+	cc := clientClasses{
+		defaultClientClass: {name: defaultClientClass, weight: 1},
+	}
+
+	for _, kv := range strings.Split(v, ",") {
+		if kv == "" {
+			continue
+		}
+
+		name, value, _ := strings.Cut(kv, "=")
+		weightStr, maxStr, hasMax := strings.Cut(value, "/")
+		c := &clientClass{name: name, weight: 1}
+
+		var err error
+		if weightStr != "" {
+			c.weight, err = strconv.ParseFloat(weightStr, 64)
+		}
+		if err == nil && hasMax {
+			c.max, err = strconv.ParseInt(maxStr, 10, 64)
+		}
+		if err != nil || name == "" || c.weight <= 0 || c.max < 0 {
+			log.Printf("ZOEKTSCHEDCLIENTS: ignoring invalid entry %q", kv)
+			continue
+		}
+
+		cc[name] = c
+	}
+
+	return cc
+}
+
 // We use a gauge and counter to track the number of processes in each
 // state. They can be one of the following states:
 //
@@ -354,20 +456,56 @@ var (
 		Name: "zoekt_shards_sched_total",
 		Help: "The total number of zoekt scheduler processes in a state.",
 	}, []string{"type", "state"})
+	metricSchedClientQueued = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "zoekt_shards_sched_client_queued",
+		Help: "The current number of queued zoekt scheduler processes per client class.",
+	}, []string{"type", "class"})
+	metricSchedClientWaitSeconds = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "zoekt_shards_sched_client_wait_seconds",
+		Help:    "The time zoekt scheduler processes were queued per client class.",
+		Buckets: prometheus.ExponentialBuckets(0.001, 4, 8),
+	}, []string{"type", "class"})
 )
 
-// sema is a semaphore which tracks its state in prometheus.
+// sema is a semaphore which tracks its state in prometheus. Waiting clients
+// are served fairly: the next slot goes to the waiting client which runs the
+// fewest processes relative to the weight of its class, in FIFO order among
+// equals.
 type sema struct {
-	sem *semaphore.Weighted
+	capacity int64
+	typ      string
+	classes  clientClasses
+
+	mu      sync.Mutex
+	running int64
+	clients map[string]*semaClient
+	seq     uint64 // orders waiters
 
 	metricQueued        *gaugeCounter
 	metricRunning       *gaugeCounter
 	metricTimedoutTotal prometheus.Counter
 }
 
-func newSema(capacity int64, typ string) *sema {
+// semaClient is the state of a client of a sema. It is removed once the
+// client neither runs nor waits.
+type semaClient struct {
+	class   *clientClass
+	running int64
+	waiters []*semaWaiter // FIFO
+}
+
+type semaWaiter struct {
+	ready   chan struct{}
+	seq     uint64
+	granted bool
+}
+
+func newSema(capacity int64, typ string, classes clientClasses) *sema {
 	return &sema{
-		sem: semaphore.NewWeighted(capacity),
+		capacity: capacity,
+		typ:      typ,
+		classes:  classes,
+		clients:  map[string]*semaClient{},
 
 		metricQueued: &gaugeCounter{
 			gauge:   metricSched.WithLabelValues(typ, "queued"),
@@ -381,26 +519,125 @@ func newSema(capacity int64, typ string) *sema {
 	}
 }
 
-func (s *sema) Acquire(ctx context.Context) error {
+func (s *sema) Acquire(ctx context.Context, client string) error {
 	s.metricQueued.Inc()
 	defer s.metricQueued.Dec()
 
-	err := s.sem.Acquire(ctx, 1)
-	if err != nil {
+	s.mu.Lock()
+	c := s.client(client)
+	w := &semaWaiter{ready: make(chan struct{}), seq: s.seq}
+	s.seq++
+	c.waiters = append(c.waiters, w)
+	s.dispatch()
+	s.mu.Unlock()
+
+	class := c.class.name
+	queued := metricSchedClientQueued.WithLabelValues(s.typ, class)
+	queued.Inc()
+	defer queued.Dec()
+	start := time.Now()
+
+	select {
+	case <-w.ready:
+	case <-ctx.Done():
+		s.mu.Lock()
+		if w.granted {
+			// We raced with dispatch, give the slot back.
+			s.release(client)
+		} else {
+			c.removeWaiter(w)
+			s.gc(client)
+		}
+		s.mu.Unlock()
+
 		s.metricTimedoutTotal.Inc()
-		return err
+		return ctx.Err()
 	}
 
+	metricSchedClientWaitSeconds.WithLabelValues(s.typ, class).Observe(time.Since(start).Seconds())
 	s.metricRunning.Inc()
 
 	return nil
 }
 
-func (s *sema) Release() {
-	s.sem.Release(1)
+func (s *sema) Release(client string) {
+	s.mu.Lock()
+	s.release(client)
+	s.mu.Unlock()
+
 	s.metricRunning.Dec()
 }
 
+// client returns the state of client, creating it if necessary. It must be
+// called with s.mu held.
+func (s *sema) client(client string) *semaClient {
+	c, ok := s.clients[client]
+	if !ok {
+		c = &semaClient{class: s.classes.get(client)}
+		s.clients[client] = c
+	}
+	return c
+}
+
+// release must be called with s.mu held.
+func (s *sema) release(client string) {
+	s.clients[client].running--
+	s.running--
+	s.gc(client)
+	s.dispatch()
+}
+
+// gc removes client if it is idle. It must be called with s.mu held.
+func (s *sema) gc(client string) {
+	if c := s.clients[client]; c.running == 0 && len(c.waiters) == 0 {
+		delete(s.clients, client)
+	}
+}
+
+// dispatch hands out free slots to waiters. It must be called with s.mu held.
+func (s *sema) dispatch() {
+	for s.running < s.capacity {
+		var next *semaClient
+		for _, c := range s.clients {
+			if len(c.waiters) == 0 || (c.class.max > 0 && c.running >= c.class.max) {
+				continue
+			}
+			if next == nil || c.before(next) {
+				next = c
+			}
+		}
+		if next == nil {
+			return
+		}
+
+		w := next.waiters[0]
+		next.waiters = next.waiters[1:]
+		w.granted = true
+		close(w.ready)
+
+		next.running++
+		s.running++
+	}
+}
+
+// before returns true if c should be served before o.
+func (c *semaClient) before(o *semaClient) bool {
+	cs, os := float64(c.running)/c.class.weight, float64(o.running)/o.class.weight
+	if cs != os {
+		return cs < os
+	}
+	return c.waiters[0].seq < o.waiters[0].seq
+}
+
+func (c *semaClient) removeWaiter(w *semaWaiter) {
+	for i, o := range c.waiters {
+		if o == w {
+			c.waiters = append(c.waiters[:i], c.waiters[i+1:]...)
+			return
+		}
+	}
+}
+
 // gaugeCounter is a wrapper around a gauge and a counter. Whenever the gauge
 // is incremented so is the counter. Decrement only affects the gauge.
 type gaugeCounter struct {
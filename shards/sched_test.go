@@ -196,3 +196,95 @@ func TestParseTuneables(t *testing.T) {
 		}
 	}
 }
+
+func TestParseClientClasses(t *testing.T) {
+	got := parseClientClasses("ui=4,dashboards=0.5/2,default=/3,bad=-1,broken=x")
+	want := clientClasses{
+		"default":    {name: "default", weight: 1, max: 3},
+		"ui":         {name: "ui", weight: 4},
+		"dashboards": {name: "dashboards", weight: 0.5, max: 2},
+	}
+	if d := cmp.Diff(want, got, cmp.AllowUnexported(clientClass{})); d != "" {
+		t.Errorf("parseClientClasses mismatch (-want, +got):\n%s", d)
+	}
+
+	if got := want.get("unknown"); got.name != "default" {
+		t.Errorf("unknown clients should be in the default class, got %q", got.name)
+	}
+}
+
+func TestSemaFairness(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	s := newSema(2, "test", parseClientClasses("ui=2,noisy=1/1"))
+
+	// noisy holds one slot and ui the other.
+	if err := s.Acquire(ctx, "noisy"); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Acquire(ctx, "ui"); err != nil {
+		t.Fatal(err)
+	}
+
+	// noisy queues more work before other and ui do.
+	order := make(chan string, 10)
+	var queued uint64 = 2
+	enqueue := func(client string) {
+		go func() {
+			if err := s.Acquire(ctx, client); err != nil {
+				t.Error(err)
+				return
+			}
+			order <- client
+		}()
+		queued++
+		for {
+			s.mu.Lock()
+			n := s.seq
+			s.mu.Unlock()
+			if n >= queued {
+				return
+			}
+			time.Sleep(time.Millisecond)
+		}
+	}
+	enqueue("noisy")
+	enqueue("noisy")
+	enqueue("other")
+	enqueue("ui")
+
+	// Each release hands out one slot. noisy is capped at 1, so it can't get
+	// the slot it frees until everyone else has been served.
+	next := func(release string) string {
+		s.Release(release)
+		select {
+		case c := <-order:
+			return c
+		case <-ctx.Done():
+			t.Fatal("timed out waiting for acquire")
+			return ""
+		}
+	}
+
+	// Once ui releases, ui and other both run nothing, so the earlier waiter
+	// wins.
+	if got := next("ui"); got != "other" {
+		t.Fatalf("got %q, want other", got)
+	}
+	if got := next("other"); got != "ui" {
+		t.Fatalf("got %q, want ui", got)
+	}
+	if got := next("noisy"); got != "noisy" {
+		t.Fatalf("got %q, want noisy", got)
+	}
+	if got := next("noisy"); got != "noisy" {
+		t.Fatalf("got %q, want noisy", got)
+	}
+	s.Release("noisy")
+	s.Release("ui")
+
+	if len(s.clients) != 0 || s.running != 0 {
+		t.Errorf("expected idle sema, got %d clients and %d running", len(s.clients), s.running)
+	}
+}
@@ -16,6 +16,7 @@ package shards
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"math"
@@ -34,6 +35,7 @@ import (
 	"go.uber.org/atomic"
 
 	"github.com/sourcegraph/zoekt"
+	"github.com/sourcegraph/zoekt/authz"
 	"github.com/sourcegraph/zoekt/query"
 	"github.com/sourcegraph/zoekt/trace"
 )
@@ -83,6 +85,10 @@ var (
 		Name: "zoekt_search_crashes_total",
 		Help: "Total number of search shards that had a crash",
 	})
+	metricSearchIncompleteShardsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "zoekt_search_incomplete_shards_total",
+		Help: "Total number of search shards reported as incomplete, by reason",
+	}, []string{"reason"})
 	metricSearchFileCountTotal = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "zoekt_search_file_count_total",
 		Help: "Total number of files containing a match",
@@ -192,6 +198,9 @@ type loaded struct {
 	// ready is true if sharded searcher has finished loading all initial
 	// shards on startup.
 	ready bool
+
+	// generation changes whenever the set of loaded shards changes.
+	generation uint64
 }
 
 type shardedSearcher struct {
@@ -204,8 +213,18 @@ type shardedSearcher struct {
 	mu     sync.Mutex // protects writes to shards
 	shards map[string]*rankedShard
 
-	ready  atomic.Bool
-	ranked atomic.Value
+	ready      atomic.Bool
+	ranked     atomic.Value
+	generation atomic.Uint64
+
+	// cache is non-nil if search results should be cached.
+	cache *resultCache
+
+	// authorizer if non-nil restricts requests to the repositories their
+	// principal may access.
+	authorizer authz.Authorizer
+
+	admission AdmissionOptions
 }
 
 func newShardedSearcher(n int64) *shardedSearcher {
@@ -232,17 +251,61 @@ func NewDirectorySearcherFast(dir string) (zoekt.Streamer, error) {
 	return newDirectorySearcher(dir, false)
 }
 
-func newDirectorySearcher(dir string, waitUntilReady bool) (zoekt.Streamer, error) {
+// NewDirectorySearcherWithBudget is like NewDirectorySearcherFast, but only
+// keeps shards loaded while their memory use stays within budget bytes. The
+// repository metadata of all shards is always kept in memory. The other
+// shards are loaded on demand, evicting the least recently used ones.
+func NewDirectorySearcherWithBudget(dir string, budget int64) (zoekt.Streamer, error) {
+	return NewDirectorySearcherWithOptions(dir, DirectorySearcherOptions{ShardMemoryBudget: budget})
+}
+
+// DirectorySearcherOptions configures NewDirectorySearcherWithOptions. The
+// zero value is equivalent to NewDirectorySearcherFast.
+type DirectorySearcherOptions struct {
+	// WaitUntilReady blocks until all shards are loaded, like
+	// NewDirectorySearcher.
+	WaitUntilReady bool
+
+	// ShardMemoryBudget if non-zero loads shards lazily, see
+	// NewDirectorySearcherWithBudget.
+	ShardMemoryBudget int64
+
+	// ResultCacheBytes if non-zero caches search results up to this size, as
+	// estimated by SearchResult.SizeBytes. Cached results are dropped
+	// whenever the loaded shards change.
+	ResultCacheBytes uint64
+
+	// Admission downgrades or rejects searches based on their estimated
+	// cost.
+	Admission AdmissionOptions
+
+	// Authorizer if non-nil restricts Search, StreamSearch and List to the
+	// repositories the principal of the request may access, see
+	// authz.WithPrincipal.
+	Authorizer authz.Authorizer
+}
+
+// NewDirectorySearcherWithOptions returns a searcher for the shards in dir
+// configured by opts.
+func NewDirectorySearcherWithOptions(dir string, opts DirectorySearcherOptions) (zoekt.Streamer, error) {
 	ss := newShardedSearcher(int64(runtime.GOMAXPROCS(0)))
+	if opts.ResultCacheBytes > 0 {
+		ss.cache = newResultCache(opts.ResultCacheBytes)
+	}
+	ss.admission = opts.Admission
+	ss.authorizer = opts.Authorizer
 	tl := &loader{
 		ss: ss,
 	}
+	if opts.ShardMemoryBudget > 0 {
+		tl.cache = newShardCache(opts.ShardMemoryBudget)
+	}
 	dw, err := newDirectoryWatcher(dir, tl)
 	if err != nil {
 		return nil, err
 	}
 
-	if waitUntilReady {
+	if opts.WaitUntilReady {
 		if err := dw.WaitUntilReady(); err != nil {
 			return nil, err
 		}
@@ -256,6 +319,10 @@ func newDirectorySearcher(dir string, waitUntilReady bool) (zoekt.Streamer, erro
 	return &typeRepoSearcher{Streamer: ds}, nil
 }
 
+func newDirectorySearcher(dir string, waitUntilReady bool) (zoekt.Streamer, error) {
+	return NewDirectorySearcherWithOptions(dir, DirectorySearcherOptions{WaitUntilReady: waitUntilReady})
+}
+
 type directorySearcher struct {
 	zoekt.Streamer
 
@@ -271,6 +338,10 @@ func (s *directorySearcher) Close() {
 
 type loader struct {
 	ss *shardedSearcher
+
+	// cache is non-nil if shards should be loaded lazily within a memory
+	// budget.
+	cache *shardCache
 }
 
 func (tl *loader) load(keys ...string) {
@@ -318,7 +389,7 @@ func (tl *loader) load(keys ...string) {
 			defer sem.Release(1)
 			defer wg.Done()
 
-			shard, err := loadShard(key)
+			shard, err := tl.loadShard(key)
 			if err != nil {
 				metricShardsLoadFailedTotal.Inc()
 				log.Printf("reloading: %s, err %v ", key, err)
@@ -337,6 +408,17 @@ func (tl *loader) load(keys ...string) {
 	publishLoaded()
 }
 
+func (tl *loader) loadShard(key string) (zoekt.Searcher, error) {
+	if tl.cache != nil {
+		s, err := newLazyShard(key, tl.cache)
+		if err != nil {
+			return nil, err
+		}
+		return s, nil
+	}
+	return loadShard(key)
+}
+
 func (tl *loader) drop(keys ...string) {
 	shards := make(map[string]zoekt.Searcher, len(keys))
 	for _, key := range keys {
@@ -495,18 +577,40 @@ func (ss *shardedSearcher) Search(ctx context.Context, q query.Q, opts *zoekt.Se
 	collectSender := newCollectSender(opts)
 
 	start := time.Now()
-	proc, err := ss.sched.Acquire(ctx)
+	loaded := ss.getLoaded()
+
+	authorized, err := ss.authorize(ctx, loaded.shards)
+	if err != nil {
+		return nil, err
+	}
+
+	var cacheKey string
+	if ss.cache != nil && loaded.ready {
+		cacheKey = authorized.cacheKey(resultCacheKey(q, opts))
+		if sr, ok := ss.cache.get(loaded.generation, cacheKey); ok {
+			tr.LazyPrintf("cache hit")
+			sr.Stats.Duration = time.Since(start)
+			return sr, nil
+		}
+	}
+
+	adm, err := ss.admission.admit(authorized.shards, q, opts)
+	if err != nil {
+		return nil, err
+	}
+	opts = adm.opts
+
+	proc, err := ss.acquire(ctx, adm)
 	if err != nil {
 		return nil, err
 	}
 	defer proc.Release()
-	tr.LazyPrintf("acquired process")
+	tr.LazyPrintf("acquired process batch=%v", adm.batch)
 
 	wait := time.Since(start)
 	start = time.Now()
 
-	loaded := ss.getLoaded()
-	done, err := streamSearch(ctx, proc, q, opts, loaded.shards, collectSender)
+	done, err := streamSearch(ctx, proc, q, opts, authorized, collectSender)
 	defer done()
 	if err != nil {
 		return nil, err
@@ -530,6 +634,12 @@ func (ss *shardedSearcher) Search(ctx context.Context, q query.Q, opts *zoekt.Se
 	aggregate.Stats.Wait = wait
 	aggregate.Stats.Duration = time.Since(start)
 
+	if cacheKey != "" && aggregate.Stats.Crashes == 0 && len(aggregate.IncompleteShards) == 0 && ctx.Err() == nil {
+		cp := *aggregate
+		cp.Files = append([]zoekt.FileMatch(nil), aggregate.Files...)
+		ss.cache.put(loaded.generation, cacheKey, &cp)
+	}
+
 	return aggregate, nil
 }
 
@@ -544,15 +654,39 @@ func (ss *shardedSearcher) StreamSearch(ctx context.Context, q query.Q, opts *zo
 	}()
 
 	start := time.Now()
-	proc, err := ss.sched.Acquire(ctx)
+	loaded := ss.getLoaded()
+
+	authorized, err := ss.authorize(ctx, loaded.shards)
+	if err != nil {
+		return err
+	}
+
+	var cacheKey string
+	if ss.cache != nil && loaded.ready {
+		cacheKey = authorized.cacheKey(resultCacheKey(q, opts))
+		if sr, ok := ss.cache.get(loaded.generation, cacheKey); ok {
+			tr.LazyPrintf("cache hit")
+			sr.Stats.Duration = time.Since(start)
+			sr.Progress.MaxPendingPriority = math.Inf(-1)
+			sender.Send(sr)
+			return nil
+		}
+	}
+
+	adm, err := ss.admission.admit(authorized.shards, q, opts)
+	if err != nil {
+		return err
+	}
+	opts = adm.opts
+
+	proc, err := ss.acquire(ctx, adm)
 	if err != nil {
 		return err
 	}
 	defer proc.Release()
-	tr.LazyPrintf("acquired process")
+	tr.LazyPrintf("acquired process batch=%v", adm.batch)
 
-	loaded := ss.getLoaded()
-	shards := loaded.shards
+	shards := authorized.shards
 
 	maxPendingPriority := math.Inf(-1)
 	if len(shards) > 0 {
@@ -583,8 +717,15 @@ func (ss *shardedSearcher) StreamSearch(ctx context.Context, q query.Q, opts *zo
 	// 4. copyFileSender (copy)
 	//
 	// For streaming, the wrapping has to happen in the inverted order.
+	var cached *resultCollector
+	if cacheKey != "" {
+		cached = newResultCollector(opts, ss.cache.maxBytes)
+		sender = cached.tee(sender)
+	}
 	sender = copyFileSender(sender)
 
+	callerCtx := ctx
+
 	if truncator, hasLimits := zoekt.NewDisplayTruncator(opts); hasLimits {
 		var cancel context.CancelFunc
 		ctx, cancel = context.WithCancel(ctx)
@@ -594,16 +735,31 @@ func (ss *shardedSearcher) StreamSearch(ctx context.Context, q query.Q, opts *zo
 
 	sender, flush := newFlushCollectSender(opts, sender)
 
-	done, err := streamSearch(ctx, proc, q, opts, shards, sender)
+	done, err := streamSearch(ctx, proc, q, opts, authorized, sender)
 
 	// Even though streaming is done, we may have results sitting in a buffer we
 	// need to flush. So we need to send those before calling done.
 	flush()
 	done()
 
+	if cached != nil && err == nil && callerCtx.Err() == nil {
+		if sr, ok := cached.done(); ok {
+			ss.cache.put(loaded.generation, cacheKey, sr)
+		}
+	}
+
 	return err
 }
 
+// acquire acquires a process from the scheduler at the priority chosen by
+// admission control.
+func (ss *shardedSearcher) acquire(ctx context.Context, adm admission) (*process, error) {
+	if adm.batch {
+		return ss.sched.AcquireBatch(ctx)
+	}
+	return ss.sched.Acquire(ctx)
+}
+
 // streamSearch is an internal helper since both Search and StreamSearch are
 // largely similar.
 //
@@ -612,7 +768,7 @@ func (ss *shardedSearcher) StreamSearch(ctx context.Context, q query.Q, opts *zo
 // collector can't see. Calling done informs the garbage collector it is free
 // to collect those shards. The caller must call copyFiles on any
 // SearchResults it returns/streams out before calling done.
-func streamSearch(ctx context.Context, proc *process, q query.Q, opts *zoekt.SearchOptions, shards []*rankedShard, sender zoekt.Sender) (done func(), err error) {
+func streamSearch(ctx context.Context, proc *process, q query.Q, opts *zoekt.SearchOptions, authorized authorized, sender zoekt.Sender) (done func(), err error) {
 	tr, ctx := trace.New(ctx, "shardedSearcher.streamSearch", "")
 	overallStart := time.Now()
 	metricSearchRunning.Inc()
@@ -628,9 +784,9 @@ func streamSearch(ctx context.Context, proc *process, q query.Q, opts *zoekt.Sea
 		tr.Finish()
 	}()
 
-	tr.LazyPrintf("before selectRepoSet shards:%d", len(shards))
+	tr.LazyPrintf("before selectRepoSet shards:%d", len(authorized.shards))
 	// Select the subset of shards that we will search over for the given query.
-	shards, q = selectRepoSet(shards, q)
+	shards, q := selectRepoSet(authorized.shards, q)
 	tr.LazyPrintf("after selectRepoSet shards:%d %s", len(shards), q)
 
 	if len(shards) == 0 {
@@ -654,7 +810,7 @@ func streamSearch(ctx context.Context, proc *process, q query.Q, opts *zoekt.Sea
 	}
 
 	type result struct {
-		priority float64
+		shard *rankedShard
 		*zoekt.SearchResult
 		err error
 	}
@@ -678,8 +834,8 @@ func streamSearch(ctx context.Context, proc *process, q query.Q, opts *zoekt.Sea
 		go func() {
 			defer wg.Done()
 			for s := range search {
-				sr, err := searchOneShard(ctx, s, q, opts)
-				r := &result{priority: s.priority, SearchResult: sr, err: err}
+				sr, err := searchOneShard(ctx, s, authorized.restrictShard(s, q), opts)
+				r := &result{shard: s, SearchResult: sr, err: err}
 				results <- r
 			}
 		}()
@@ -712,6 +868,9 @@ func streamSearch(ctx context.Context, proc *process, q query.Q, opts *zoekt.Sea
 	// tracked so we can stop when we hit TotalMaxMatchCount
 	var totalMatchCount int
 
+	// shards whose results are missing or incomplete, sent once we are done.
+	var incomplete []zoekt.IncompleteShard
+
 search:
 	for {
 		// At the top of each iteration, have the proc associated with this search yield its won "timeslice"
@@ -734,7 +893,7 @@ search:
 			}
 
 			// delete this result's priority from pending before computing the new max pending priority
-			pending.remove(r.priority)
+			pending.remove(r.shard.priority)
 
 			if r.err != nil {
 				// Set final error and stop searching new shards, but consume any pending
@@ -753,16 +912,45 @@ search:
 
 			observeMetrics(r.SearchResult)
 
-			r.Priority = r.priority
+			if reason, ok := incompleteReason(ctx, r.SearchResult); ok {
+				metricSearchIncompleteShardsTotal.WithLabelValues(string(reason)).Inc()
+				incomplete = append(incomplete, authorized.incomplete(r.shard, reason))
+			}
+
+			r.Priority = r.shard.priority
 			r.MaxPendingPriority = pending.max()
 
 			sendByRepository(r.SearchResult, opts, sender) // send the result back to the client
 		}
 	}
 
+	if len(incomplete) > 0 {
+		sender.Send(&zoekt.SearchResult{
+			Progress: zoekt.Progress{
+				Priority:           math.Inf(-1),
+				MaxPendingPriority: math.Inf(-1),
+			},
+			IncompleteShards: incomplete,
+		})
+	}
+
 	return func() { runtime.KeepAlive(shards) }, err
 }
 
+// incompleteReason returns why the result of a shard searched with ctx is
+// incomplete. ok is false if the result is complete.
+func incompleteReason(ctx context.Context, sr *zoekt.SearchResult) (_ zoekt.IncompleteReason, ok bool) {
+	if sr.Stats.Crashes > 0 {
+		return zoekt.IncompleteCrashed, true
+	}
+	// Shards skip documents when they hit match limits as well, so we only
+	// blame skipped documents on the deadline once it has passed.
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) && (sr.Stats.ShardsSkipped > 0 || sr.Stats.FilesSkipped > 0) {
+		return zoekt.IncompleteTimeout, true
+	}
+	return "", false
+}
+
 // sendByRepository splits a zoekt.SearchResult by repository and calls
 // sender.Send for each batch. Ranking in Sourcegraph expects zoekt.SearchResult
 // to contain results with the same zoekt.SearchResult.Priority only.
@@ -920,12 +1108,16 @@ func (ss *shardedSearcher) List(ctx context.Context, r query.Q, opts *zoekt.List
 	tr.LazyPrintf("acquired process")
 
 	loaded := ss.getLoaded()
-	shards := loaded.shards
+	authorized, err := ss.authorize(ctx, loaded.shards)
+	if err != nil {
+		return nil, err
+	}
+	shards := authorized.shards
 	shardCount := len(shards)
 	all := make(chan shardListResult, shardCount)
 	tr.LazyPrintf("shardCount: %d", len(shards))
 
-	feeder := make(chan zoekt.Searcher, len(shards))
+	feeder := make(chan *rankedShard, len(shards))
 	for _, s := range shards {
 		feeder <- s
 	}
@@ -934,7 +1126,7 @@ func (ss *shardedSearcher) List(ctx context.Context, r query.Q, opts *zoekt.List
 	for i := 0; i < runtime.GOMAXPROCS(0); i++ {
 		go func() {
 			for s := range feeder {
-				listOneShard(ctx, s, r, opts, all)
+				listOneShard(ctx, s, authorized.restrictShard(s, r), opts, all)
 			}
 		}()
 	}
@@ -1026,10 +1218,12 @@ func (s *shardedSearcher) getLoaded() loaded {
 	ready := s.ready.Load()
 	// ranked is loaded after ready to avoid a race were ready is true but
 	// ranked is still not the final set of shards.
+	generation := s.generation.Load()
 	ranked, _ := s.ranked.Load().([]*rankedShard)
 	return loaded{
-		shards: ranked,
-		ready:  ready,
+		shards:     ranked,
+		ready:      ready,
+		generation: generation,
 	}
 }
 
@@ -1145,6 +1339,14 @@ func (s *shardedSearcher) replace(shards map[string]zoekt.Searcher) {
 
 	s.ranked.Store(ranked)
 
+	// Readers load the generation before the shards, so results computed on
+	// the new shards are never cached under the old generation for long:
+	// invalidate drops them.
+	generation := s.generation.Add(1)
+	if s.cache != nil {
+		s.cache.invalidate(generation)
+	}
+
 	metricShardsLoaded.Set(float64(len(ranked)))
 }
 
@@ -93,6 +93,62 @@ func TestCrashResilience(t *testing.T) {
 	t.Run("ready", test)
 }
 
+// slowSearcher only returns once the search is cancelled.
+type slowSearcher struct {
+	crashSearcher
+}
+
+func (s *slowSearcher) Search(ctx context.Context, q query.Q, opts *zoekt.SearchOptions) (*zoekt.SearchResult, error) {
+	<-ctx.Done()
+	return &zoekt.SearchResult{Stats: zoekt.Stats{ShardsSkipped: 1}}, nil
+}
+
+func (s *slowSearcher) String() string { return "slowSearcher" }
+
+func TestIncompleteShards(t *testing.T) {
+	out := &bytes.Buffer{}
+	log.SetOutput(out)
+	defer log.SetOutput(os.Stderr)
+
+	ss := newShardedSearcher(2)
+	ss.ranked.Store([]*rankedShard{
+		{Searcher: &crashSearcher{}, repos: []*zoekt.Repository{{Name: "crashed"}}},
+		{Searcher: &slowSearcher{}, repos: []*zoekt.Repository{{Name: "slow"}}},
+	})
+	ss.markReady()
+
+	want := []zoekt.IncompleteShard{
+		{Shard: "crashSearcher", Repos: []string{"crashed"}, Reason: zoekt.IncompleteCrashed},
+		{Shard: "slowSearcher", Repos: []string{"slow"}, Reason: zoekt.IncompleteTimeout},
+	}
+	sortIncomplete := cmpopts.SortSlices(func(a, b zoekt.IncompleteShard) bool { return a.Shard < b.Shard })
+
+	q := &query.Substring{Pattern: "hoi"}
+	opts := &zoekt.SearchOptions{MaxWallTime: 10 * time.Millisecond}
+
+	res, err := ss.Search(context.Background(), q, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d := cmp.Diff(want, res.IncompleteShards, sortIncomplete); d != "" {
+		t.Errorf("Search: mismatch (-want +got):\n%s", d)
+	}
+	if d := cmp.Diff([]string{"crashed", "slow"}, res.IncompleteRepos(), cmpopts.SortSlices(func(a, b string) bool { return a < b })); d != "" {
+		t.Errorf("IncompleteRepos: mismatch (-want +got):\n%s", d)
+	}
+
+	var got []zoekt.IncompleteShard
+	err = ss.StreamSearch(context.Background(), q, opts, stream.SenderFunc(func(sr *zoekt.SearchResult) {
+		got = append(got, sr.IncompleteShards...)
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d := cmp.Diff(want, got, sortIncomplete); d != "" {
+		t.Errorf("StreamSearch: mismatch (-want +got):\n%s", d)
+	}
+}
+
 type rankSearcher struct {
 	rank uint16
 	repo *zoekt.Repository
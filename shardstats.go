@@ -0,0 +1,259 @@
+package zoekt
+
+import (
+	"bytes"
+	"math/bits"
+	"regexp"
+	"sort"
+)
+
+// ShardStats summarizes what takes up space in an index shard. It is meant
+// for debugging unexpectedly large shards, see cmd/zoekt-shard-stats.
+type ShardStats struct {
+	Name          string
+	IndexMetadata IndexMetadata
+
+	// Sections lists the sections of the TOC in on-disk order.
+	Sections []SectionStats
+
+	Repos     []RepoShardStats
+	Languages map[string]int
+
+	// TopContentNgrams and TopNameNgrams are the ngrams with the largest
+	// posting lists.
+	TopContentNgrams []NgramStats
+	TopNameNgrams    []NgramStats
+
+	// PostingSizes is a histogram of the size in bytes of the content
+	// posting lists, bucketed by powers of 2.
+	PostingSizes []PostingSizeBucket
+
+	LargestDocuments []DocumentStats
+
+	// SkipReasons counts the documents which were not indexed, keyed by
+	// skip reason. Numbers in the reason are replaced by "N" so that
+	// similar reasons are counted together.
+	SkipReasons map[string]int
+
+	// Tombstones lists the names of tombstoned repositories.
+	Tombstones []string
+
+	// SecretFindings lists the secrets found while indexing the shard.
+	SecretFindings []SecretFinding
+}
+
+// SectionStats describes the location of a section in the index file. For
+// compound sections Size includes the index of item offsets.
+type SectionStats struct {
+	Name   string
+	Offset uint32
+	Size   uint32
+}
+
+type RepoShardStats struct {
+	Name         string
+	ID           uint32
+	Documents    int
+	ContentBytes int64
+	Tombstone    bool
+
+	// FileTombstones is the number of files which delta builds replaced in
+	// later shards. Their documents still take up space in this shard.
+	FileTombstones int
+}
+
+type NgramStats struct {
+	Ngram string
+
+	// PostingBytes is the size of the posting list on disk.
+	PostingBytes uint32
+
+	// Postings is the number of occurrences of the ngram.
+	Postings int
+}
+
+// PostingSizeBucket counts the posting lists whose size s in bytes is in
+// [Min, 2*Min). The first bucket has Min = 0 and counts empty lists.
+type PostingSizeBucket struct {
+	Min   uint32
+	Count int
+	Bytes uint64
+}
+
+type DocumentStats struct {
+	Repository string
+	Name       string
+	Size       uint32
+}
+
+// ShardStatsOptions controls how much detail ReadShardStats collects.
+type ShardStatsOptions struct {
+	// TopN is the number of ngrams and documents to report. Defaults to 10.
+	TopN int
+}
+
+var digitsRegexp = regexp.MustCompile(`[0-9]+`)
+
+// ReadShardStats collects statistics about the index shard in f. Unlike
+// NewSearcher, it reads through all posting lists, so it should not be used
+// on the hot path.
+func ReadShardStats(f IndexFile, opts ShardStatsOptions) (*ShardStats, error) {
+	if opts.TopN <= 0 {
+		opts.TopN = 10
+	}
+
+	rd := &reader{r: f}
+	var toc indexTOC
+	if err := rd.readTOC(&toc); err != nil {
+		return nil, err
+	}
+	d, err := rd.readIndexData(&toc)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &ShardStats{
+		Name:           f.Name(),
+		IndexMetadata:  d.metaData,
+		Languages:      map[string]int{},
+		SkipReasons:    map[string]int{},
+		SecretFindings: d.secretFindings,
+	}
+
+	for _, ent := range toc.sectionsTaggedList() {
+		st := SectionStats{Name: ent.tag}
+		switch sec := ent.sec.(type) {
+		case *simpleSection:
+			st.Offset, st.Size = sec.off, sec.sz
+		case *compoundSection:
+			st.Offset, st.Size = sec.data.off, sec.data.sz+sec.index.sz
+		case *lazyCompoundSection:
+			st.Offset, st.Size = sec.data.off, sec.data.sz+sec.index.sz
+		}
+		if st.Size == 0 {
+			continue
+		}
+		s.Sections = append(s.Sections, st)
+	}
+	sort.Slice(s.Sections, func(i, j int) bool {
+		return s.Sections[i].Offset < s.Sections[j].Offset
+	})
+
+	for _, md := range d.repoMetaData {
+		s.Repos = append(s.Repos, RepoShardStats{
+			Name:           md.Name,
+			ID:             md.ID,
+			Tombstone:      md.Tombstone,
+			FileTombstones: len(md.FileTombstones),
+		})
+		if md.Tombstone {
+			s.Tombstones = append(s.Tombstones, md.Name)
+		}
+	}
+
+	var docs []DocumentStats
+	marker := []byte(notIndexedMarker)
+	for i := uint32(0); i < d.numDocs(); i++ {
+		repo := &s.Repos[d.repos[i]]
+		size := d.boundaries[i+1] - d.boundaries[i]
+		repo.Documents++
+		repo.ContentBytes += int64(size)
+
+		s.Languages[d.languageMap[d.getLanguage(i)]]++
+		docs = append(docs, DocumentStats{
+			Repository: repo.Name,
+			Name:       string(d.fileName(i)),
+			Size:       size,
+		})
+
+		if size < uint32(len(marker)) {
+			continue
+		}
+		content, err := d.readContents(i)
+		if err != nil {
+			return nil, err
+		}
+		if bytes.HasPrefix(content, marker) {
+			reason := string(content[len(marker):])
+			s.SkipReasons[digitsRegexp.ReplaceAllString(reason, "N")]++
+		}
+	}
+
+	sort.SliceStable(docs, func(i, j int) bool {
+		return docs[i].Size > docs[j].Size
+	})
+	if len(docs) > opts.TopN {
+		docs = docs[:opts.TopN]
+	}
+	s.LargestDocuments = docs
+
+	contentPostings := d.contentNgrams.DumpMap()
+	s.PostingSizes = postingSizeHistogram(contentPostings)
+	if s.TopContentNgrams, err = d.topNgrams(contentPostings, opts.TopN); err != nil {
+		return nil, err
+	}
+	if s.TopNameNgrams, err = d.topNgrams(d.fileNameNgrams.DumpMap(), opts.TopN); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// topNgrams returns the n ngrams with the largest posting lists.
+func (d *indexData) topNgrams(postings map[ngram]simpleSection, n int) ([]NgramStats, error) {
+	ngrams := make([]ngram, 0, len(postings))
+	for ng := range postings {
+		ngrams = append(ngrams, ng)
+	}
+	sort.Slice(ngrams, func(i, j int) bool {
+		si, sj := postings[ngrams[i]].sz, postings[ngrams[j]].sz
+		if si != sj {
+			return si > sj
+		}
+		return ngrams[i] < ngrams[j]
+	})
+	if len(ngrams) > n {
+		ngrams = ngrams[:n]
+	}
+
+	out := make([]NgramStats, 0, len(ngrams))
+	for _, ng := range ngrams {
+		sec := postings[ng]
+		blob, err := d.readSectionBlob(sec)
+		if err != nil {
+			return nil, err
+		}
+		// Postings are varint encoded deltas, so every byte without the
+		// continuation bit terminates one entry.
+		count := 0
+		for _, b := range blob {
+			if b&0x80 == 0 {
+				count++
+			}
+		}
+		out = append(out, NgramStats{
+			Ngram:        ng.String(),
+			PostingBytes: sec.sz,
+			Postings:     count,
+		})
+	}
+	return out, nil
+}
+
+func postingSizeHistogram(postings map[ngram]simpleSection) []PostingSizeBucket {
+	var buckets []PostingSizeBucket
+	for _, sec := range postings {
+		// bucket 0 holds empty lists, bucket i holds [2^(i-1), 2^i).
+		i := bits.Len32(sec.sz)
+		for len(buckets) <= i {
+			lo := uint32(0)
+			if len(buckets) > 0 {
+				lo = 1 << (len(buckets) - 1)
+			}
+			buckets = append(buckets, PostingSizeBucket{Min: lo})
+		}
+		buckets[i].Count++
+		buckets[i].Bytes += uint64(sec.sz)
+	}
+	return buckets
+}
@@ -0,0 +1,67 @@
+package zoekt
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestReadShardStats(t *testing.T) {
+	repo := &Repository{Name: "repo", ID: 1, FileTombstones: map[string]struct{}{"old.go": {}, "gone.go": {}}}
+	b := testIndexBuilder(t, repo,
+		Document{Name: "a.go", Content: []byte("package main\n\nfunc aaaa() {}\n"), Language: "Go"},
+		Document{Name: "b.go", Content: []byte("package b\n"), Language: "Go"},
+		Document{Name: "c.md", Content: []byte("aaaa"), Language: "Markdown"},
+		Document{Name: "big.bin", Content: []byte("binary\x00content"), SkipReason: "binary content at byte offset 6"},
+	)
+	findings := []SecretFinding{{Repository: "repo", FileName: "c.md", Rule: "api-key", Line: 1, Action: SecretRedacted}}
+	b.AddSecretFindings(findings...)
+
+	var buf bytes.Buffer
+	if err := b.Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	s, err := ReadShardStats(&memSeeker{buf.Bytes()}, ShardStatsOptions{TopN: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if d := cmp.Diff(map[string]int{"Go": 2, "Markdown": 1, "binary": 1}, s.Languages); d != "" {
+		t.Errorf("languages mismatch (-want +got):\n%s", d)
+	}
+	if d := cmp.Diff(map[string]int{"binary content at byte offset N": 1}, s.SkipReasons); d != "" {
+		t.Errorf("skip reasons mismatch (-want +got):\n%s", d)
+	}
+
+	if d := cmp.Diff(findings, s.SecretFindings); d != "" {
+		t.Errorf("secret findings mismatch (-want +got):\n%s", d)
+	}
+
+	if len(s.Repos) != 1 || s.Repos[0].Documents != 4 || s.Repos[0].FileTombstones != 2 {
+		t.Errorf("got repos %+v, want 1 repo with 4 documents and 2 file tombstones", s.Repos)
+	}
+
+	if len(s.LargestDocuments) != 2 || s.LargestDocuments[0].Name != "big.bin" {
+		t.Errorf("got largest documents %+v, want big.bin first", s.LargestDocuments)
+	}
+
+	if len(s.TopContentNgrams) != 2 {
+		t.Fatalf("got %d top ngrams, want 2", len(s.TopContentNgrams))
+	}
+	// "aaa" occurs twice in a.go and twice in c.md.
+	if got := s.TopContentNgrams[0]; got.Ngram != "aaa" || got.Postings != 4 {
+		t.Errorf("got top ngram %+v, want aaa with 4 postings", got)
+	}
+
+	sections := map[string]bool{}
+	for _, sec := range s.Sections {
+		sections[sec.Name] = true
+	}
+	for _, want := range []string{"metaData", "fileContents", "postings"} {
+		if !sections[want] {
+			t.Errorf("missing section %q in %v", want, s.Sections)
+		}
+	}
+}
@@ -175,8 +175,10 @@ func (s *samplingSender) Send(event *zoekt.SearchResult) {
 
 		s.agg.Stats.Add(event.Stats)
 		s.agg.Progress = event.Progress
+		s.agg.BackendErrors = append(s.agg.BackendErrors, event.BackendErrors...)
+		s.agg.IncompleteShards = append(s.agg.IncompleteShards, event.IncompleteShards...)
 
-		if s.aggCount%100 == 0 && !s.agg.Stats.Zero() {
+		if s.aggCount%100 == 0 && !s.aggZero() {
 			s.next.Send(&s.agg)
 			s.agg = zoekt.SearchResult{}
 		}
@@ -187,8 +189,10 @@ func (s *samplingSender) Send(event *zoekt.SearchResult) {
 	// If we have aggregate stats, we merge them with the new event before sending
 	// it. We drop agg.Progress, because we assume that event.Progress reflects the
 	// latest status.
-	if !s.agg.Stats.Zero() {
+	if !s.aggZero() {
 		event.Stats.Add(s.agg.Stats)
+		event.BackendErrors = append(s.agg.BackendErrors, event.BackendErrors...)
+		event.IncompleteShards = append(s.agg.IncompleteShards, event.IncompleteShards...)
 		s.agg = zoekt.SearchResult{}
 	}
 
@@ -197,9 +201,11 @@ func (s *samplingSender) Send(event *zoekt.SearchResult) {
 
 // Flush sends any aggregated stats that we haven't sent yet
 func (s *samplingSender) Flush() {
-	if !s.agg.Stats.Zero() {
+	if !s.aggZero() {
 		s.next.Send(&zoekt.SearchResult{
-			Stats: s.agg.Stats,
+			Stats:            s.agg.Stats,
+			BackendErrors:    s.agg.BackendErrors,
+			IncompleteShards: s.agg.IncompleteShards,
 			Progress: zoekt.Progress{
 				Priority:           math.Inf(-1),
 				MaxPendingPriority: math.Inf(-1),
@@ -207,3 +213,8 @@ func (s *samplingSender) Flush() {
 		})
 	}
 }
+
+// aggZero returns true if there is nothing aggregated to send.
+func (s *samplingSender) aggZero() bool {
+	return s.agg.Stats.Zero() && len(s.agg.BackendErrors) == 0 && len(s.agg.IncompleteShards) == 0
+}
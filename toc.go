@@ -43,7 +43,9 @@ const IndexFormatVersion = 16
 // 10: Compound shards; more flexible TOC format.
 // 11: Bloom filters for file names & contents
 // 12: go-enry for identifying file languages
-const FeatureVersion = 12
+// 13: branch sets with more than 64 branches
+// 14: commit documents
+const FeatureVersion = 14
 
 // WriteMinFeatureVersion and ReadMinFeatureVersion constrain forwards and backwards
 // compatibility. For example, if a new way to encode filenameNgrams on disk is
@@ -65,6 +67,15 @@ const WriteMinFeatureVersion = 10
 // load a file with a FeatureVersion below it.
 const ReadMinFeatureVersion = 8
 
+// branchMasksHighFeatureVersion is the minimum reader version of shards
+// with a branchMasksHigh section. Older readers would ignore the section and
+// lose the branches beyond the first 64.
+const branchMasksHighFeatureVersion = 13
+
+// fileKindsFeatureVersion is the minimum reader version of shards with a
+// fileKinds section. Older readers would return commit documents as files.
+const fileKindsFeatureVersion = 14
+
 // 17: compound shard (multi repo)
 const NextIndexFormatVersion = 17
 
@@ -99,6 +110,33 @@ type indexTOC struct {
 	repos simpleSection
 
 	ranks simpleSection
+
+	// fileReferences is only written if a document has references. It is
+	// optional, since shards without it can still be searched.
+	fileReferences compoundSection
+
+	// secretFindings is only written if secrets were found, see
+	// SecretFinding.
+	secretFindings simpleSection
+
+	// fileEncodings holds the original encoding of each document, see
+	// Document.Encoding. It is only written if a document was transcoded.
+	fileEncodings compoundSection
+
+	// branchMasksHigh holds the words of the branch bitmap of each document
+	// after the first one, which is in branchMasks. It is only written if a
+	// repository has more than 64 branches, see branchSet.
+	branchMasksHigh compoundSection
+
+	// fileKinds holds a docKind byte for each document. It is only written
+	// if the shard has commit documents, see Document.Commit.
+	fileKinds simpleSection
+
+	// fileAuthors holds the author ID of each document, and authorNames the
+	// distinct authors, see Document.Author. ID i > 0 is authorNames[i-1].
+	// They are only written if an author is known.
+	fileAuthors simpleSection
+	authorNames compoundSection
 }
 
 func (t *indexTOC) sections() []section {
@@ -188,7 +226,36 @@ func (t *indexTOC) sectionsTaggedList() []taggedSection {
 		{"contentBloom", &unusedSimple},
 
 		{"ranks", &t.ranks},
+		{"fileReferences", &t.fileReferences},
+		{"secretFindings", &t.secretFindings},
+		{"fileEncodings", &t.fileEncodings},
+		{"branchMasksHigh", &t.branchMasksHigh},
+		{"fileKinds", &t.fileKinds},
+		{"fileAuthors", &t.fileAuthors},
+		{"authorNames", &t.authorNames},
+	}
+}
+
+// omitted returns true for optional sections which were not written. They
+// are left out of the TOC, so that shards without them are unchanged.
+func (t *indexTOC) omitted(sec section) bool {
+	switch sec {
+	case &t.fileReferences:
+		return len(t.fileReferences.offsets) == 0
+	case &t.secretFindings:
+		return t.secretFindings.sz == 0
+	case &t.fileEncodings:
+		return len(t.fileEncodings.offsets) == 0
+	case &t.branchMasksHigh:
+		return len(t.branchMasksHigh.offsets) == 0
+	case &t.fileKinds:
+		return t.fileKinds.sz == 0
+	case &t.fileAuthors:
+		return t.fileAuthors.sz == 0
+	case &t.authorNames:
+		return len(t.authorNames.offsets) == 0
 	}
+	return false
 }
 
 // sectionsTaggedCompatibilityList returns a list of sections that will be
@@ -44,6 +44,9 @@ type ResultInput struct {
 	Stats       zoekt.Stats
 	Duration    time.Duration
 	FileMatches []*FileMatch
+
+	// IncompleteRepos are the repositories whose results may be incomplete.
+	IncompleteRepos []string
 }
 
 // FileMatch holds the per file data provided to search results template
@@ -52,6 +55,19 @@ type FileMatch struct {
 	Repo     string
 	ResultID string
 	Language string
+
+	// Encoding is the encoding of the original file, if it was transcoded
+	// to UTF-8 for indexing.
+	Encoding string
+
+	// Commit is true if the match is a commit rather than a file. FileName
+	// is then the commit hash and URL links to the commit.
+	Commit bool
+
+	// Author is the author of the last commit touching the file, if it was
+	// recorded while indexing.
+	Author string
+
 	// If this was a duplicate result, this will contain the file
 	// of the first match.
 	DuplicateID string
@@ -129,4 +145,9 @@ type PrintInput struct {
 	Repo, Name string
 	Lines      []string
 	Last       LastInput
+
+	// Encoding is the encoding of the original file, and OriginalURL serves
+	// the file in that encoding. They are empty for UTF-8 files.
+	Encoding    string
+	OriginalURL string
 }
@@ -198,6 +198,55 @@ func TestPrint(t *testing.T) {
 	}
 }
 
+func TestPrintOriginal(t *testing.T) {
+	b, err := zoekt.NewIndexBuilder(&zoekt.Repository{Name: "name"})
+	if err != nil {
+		t.Fatalf("NewIndexBuilder: %v", err)
+	}
+	if err := b.Add(zoekt.Document{
+		Name:     "latin.txt",
+		Content:  []byte("café\n"),
+		Encoding: "ISO-8859-1",
+	}); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+
+	srv := Server{
+		Searcher: searcherForTest(t, b),
+		Top:      Top,
+		HTML:     true,
+		Print:    true,
+	}
+	mux, err := NewMux(&srv)
+	if err != nil {
+		t.Fatalf("NewMux: %v", err)
+	}
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	checkNeedles(t, ts, "/search?q=caf", []string{"encoding ISO-8859-1"})
+	checkNeedles(t, ts, "/print?q=caf&r=name&f=latin.txt", []string{
+		"café",
+		`href="print?f=latin.txt&amp;format=original&amp;q=caf&amp;r=name"`,
+	})
+
+	res, err := http.Get(ts.URL + "/print?q=caf&r=name&f=latin.txt&format=original")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+	got, err := io.ReadAll(res.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != "caf\xe9\n" {
+		t.Errorf("got original %q, want %q", got, "caf\xe9\n")
+	}
+	if ct := res.Header.Get("Content-Type"); ct != "text/plain; charset=ISO-8859-1" {
+		t.Errorf("got Content-Type %q", ct)
+	}
+}
+
 func TestPrintDefault(t *testing.T) {
 	b, err := zoekt.NewIndexBuilder(&zoekt.Repository{
 		Name:     "name",
@@ -708,6 +757,10 @@ type crashSearcher struct {
 func (s *crashSearcher) Search(ctx context.Context, q query.Q, opts *zoekt.SearchOptions) (*zoekt.SearchResult, error) {
 	res := zoekt.SearchResult{}
 	res.Stats.Crashes = 1
+	res.IncompleteShards = []zoekt.IncompleteShard{
+		{Shard: "shard(a.zoekt)", Repos: []string{"repo-a", "repo-b"}, Reason: zoekt.IncompleteCrashed},
+		{Shard: "shard(b.zoekt)", Repos: []string{"repo-b"}, Reason: zoekt.IncompleteTimeout},
+	}
 	return &res, nil
 }
 
@@ -740,6 +793,9 @@ func TestCrash(t *testing.T) {
 	if want := "1 shards crashed"; !strings.Contains(result, want) {
 		t.Errorf("result did not have %q: %s", want, result)
 	}
+	if want := "Results may be incomplete for repos repo-a, repo-b"; !strings.Contains(result, want) {
+		t.Errorf("result did not have %q: %s", want, result)
+	}
 }
 
 func TestHostCustomization(t *testing.T) {
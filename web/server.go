@@ -36,6 +36,7 @@ import (
 	"github.com/sourcegraph/zoekt/query"
 	"github.com/sourcegraph/zoekt/rpc"
 	"github.com/sourcegraph/zoekt/stream"
+	"golang.org/x/text/encoding/ianaindex"
 )
 
 var Funcmap = template.FuncMap{
@@ -309,10 +310,11 @@ func (s *Server) serveSearchErr(r *http.Request) (*ApiSearchResult, error) {
 			Num:       num,
 			AutoFocus: true,
 		},
-		Stats:       result.Stats,
-		Query:       q.String(),
-		QueryStr:    queryStr,
-		FileMatches: fileMatches,
+		Stats:           result.Stats,
+		Query:           q.String(),
+		QueryStr:        queryStr,
+		FileMatches:     fileMatches,
+		IncompleteRepos: result.IncompleteRepos(),
 	}
 	if res.Stats.Wait < res.Stats.Duration/10 {
 		// Suppress queueing stats if they are neglible.
@@ -330,6 +332,22 @@ func (s *Server) servePrint(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// writeOriginal writes the content of f in the encoding of the original
+// file, see zoekt.FileMatch.Encoding.
+func writeOriginal(w http.ResponseWriter, f *zoekt.FileMatch) error {
+	enc, err := ianaindex.IANA.Encoding(f.Encoding)
+	if err != nil {
+		return err
+	}
+	content, err := enc.NewEncoder().Bytes(f.Content)
+	if err != nil {
+		return fmt.Errorf("encoding to %s: %w", f.Encoding, err)
+	}
+	w.Header().Set("Content-Type", "text/plain; charset="+f.Encoding)
+	_, _ = w.Write(content)
+	return nil
+}
+
 const statsStaleNess = 30 * time.Second
 
 func (s *Server) fetchStats(ctx context.Context) (*zoekt.RepoStats, error) {
@@ -592,6 +610,10 @@ func (s *Server) servePrintErr(w http.ResponseWriter, r *http.Request) error {
 
 	f := result.Files[0]
 
+	if qvals.Get("format") == "original" && f.Encoding != "" {
+		return writeOriginal(w, &f)
+	}
+
 	byteLines := bytes.Split(f.Content, []byte{'\n'})
 	strLines := make([]string, 0, len(byteLines))
 	for _, l := range byteLines {
@@ -607,6 +629,12 @@ func (s *Server) servePrintErr(w http.ResponseWriter, r *http.Request) error {
 			Num:       num,
 			AutoFocus: false,
 		},
+		Encoding: f.Encoding,
+	}
+	if f.Encoding != "" {
+		orig := r.URL.Query()
+		orig.Set("format", "original")
+		d.OriginalURL = "print?" + orig.Encode()
 	}
 
 	var buf bytes.Buffer
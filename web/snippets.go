@@ -97,6 +97,9 @@ func (s *Server) formatResults(result *zoekt.SearchResult, query string, localPr
 			ResultID:   f.Repository + ":" + f.FileName,
 			Branches:   f.Branches,
 			Language:   f.Language,
+			Encoding:   f.Encoding,
+			Commit:     f.Commit,
+			Author:     f.Author,
 			Score:      f.Score,
 			ScoreDebug: f.Debug,
 		}
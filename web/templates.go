@@ -170,12 +170,15 @@ document.onkeydown=function(e){
           <dt><a href="search?q=-%28Path File%29 Stream">-(Path File) Stream</a></dt><dd>search "Stream", but exclude files containing both "Path" and "File"</dd>
           <dt><a href="search?q=-Path%5c+file+Stream">-Path\ file Stream</a></dt><dd>search "Stream", but exclude files containing "Path File"</dd>
           <dt><a href="search?q=sym:data">sym:data</a></span></dt><dd>search for symbol definitions containing "data"</dd>
+          <dt><a href="search?q=ref:data">ref:data</a></span></dt><dd>search for references to symbols containing "data", e.g. calls</dd>
           <dt><a href="search?q=phone+r:droid">phone r:droid</a></dt><dd>search for "phone" in repositories whose name contains "droid"</dd>
           <dt><a href="search?q=phone+archived:no">phone archived:no</a></dt><dd>search for "phone" in repositories that are not archived</dd>
           <dt><a href="search?q=phone+fork:no">phone fork:no</a></dt><dd>search for "phone" in repositories that are not forks</dd>
           <dt><a href="search?q=phone+public:no">phone public:no</a></dt><dd>search for "phone" in repositories that are not public</dd>
           <dt><a href="search?q=phone+b:master">phone b:master</a></dt><dd>for Git repos, find "phone" in files in branches whose name contains "master".</dd>
           <dt><a href="search?q=phone+b:HEAD">phone b:HEAD</a></dt><dd>for Git repos, find "phone" in the default ('HEAD') branch.</dd>
+          <dt><a href="search?q=phone+author:jane">phone author:jane</a></dt><dd>for Git repos indexed with -index_authors, find "phone" in files last changed by an author whose name or email contains "jane".</dd>
+          <dt><a href="search?q=commit:fix">commit:fix</a></dt><dd>for Git repos indexed with -index_commits, find recent commits mentioning "fix".</dd>
         </dl>
       </div>
       <div class="col-md-4">
@@ -216,6 +219,7 @@ document.onkeydown=function(e){
   <div class="container-fluid container-results">
     <h5>
       {{if .Stats.Crashes}}<br><b>{{.Stats.Crashes}} shards crashed</b><br>{{end}}
+      {{if .IncompleteRepos}}<br><b>Results may be incomplete for repos {{range $i, $r := .IncompleteRepos}}{{if $i}}, {{end}}{{$r}}{{end}}</b><br>{{end}}
       {{ $fileCount := len .FileMatches }}
       Found {{.Stats.MatchCount}} results in {{.Stats.FileCount}} files{{if or (lt $fileCount .Stats.FileCount) (or (gt .Stats.ShardsSkipped 0) (gt .Stats.FilesSkipped 0)) }},
         showing top {{ $fileCount }} files (<a rel="nofollow"
@@ -234,6 +238,9 @@ document.onkeydown=function(e){
               {{if .Language}}<button
                    title="restrict search to files written in {{.Language}}"
                    onclick="zoektAddQ('lang:&quot;{{.Language}}&quot;')" class="label label-primary">language {{.Language}}</button></span>{{end}}
+              {{if .Encoding}}<span class="label label-default" title="transcoded to UTF-8 for search">encoding {{.Encoding}}</span>{{end}}
+              {{if .Commit}}<span class="label label-default">commit</span>{{end}}
+              {{if .Author}}<span class="label label-default" title="author of the last commit touching the file">author {{.Author}}</span>{{end}}
               {{if .DuplicateID}}<a class="label label-dup" href="#{{.DuplicateID}}">Duplicate result</a>{{end}}
             </small>
           </th>
@@ -337,7 +344,7 @@ document.onkeydown=function(e){
 <body id="results">
   {{template "navbar" .Last}}
   <div class="container-fluid container-results" >
-     <div><b>{{.Name}}</b></div>
+     <div><b>{{.Name}}</b>{{if .Encoding}} <a class="label label-default" href="{{.OriginalURL}}" title="transcoded to UTF-8 for search">original {{.Encoding}}</a>{{end}}</div>
      <div class="table table-hover table-condensed" style="overflow:auto; background: #eef;">
        {{ range $index, $ln := .Lines}}
 	 <pre id="l{{Inc $index}}" class="inline-pre"><span class="noselect"><a href="#l{{Inc $index}}">{{Inc $index}}</a>: </span>{{$ln}}</pre>
@@ -39,6 +39,9 @@ func (w *writer) writeTOC(toc *indexTOC) {
 	w.U32(0)
 	secs := toc.sectionsTaggedList()
 	for _, s := range secs {
+		if toc.omitted(s.sec) {
+			continue
+		}
 		w.String(s.tag)
 		w.Varint(uint32(s.sec.kind()))
 		s.sec.write(w)
@@ -133,12 +136,56 @@ func (b *IndexBuilder) Write(out io.Writer) error {
 	}
 	toc.branchMasks.end(w)
 
+	if b.hasBranchMasksHigh {
+		toc.branchMasksHigh.start(w)
+		for _, m := range b.branchMasksHigh {
+			toc.branchMasksHigh.addItem(w, m)
+		}
+		toc.branchMasksHigh.end(w)
+	}
+
 	toc.fileSections.start(w)
 	for _, s := range b.docSections {
 		toc.fileSections.addItem(w, marshalDocSections(s))
 	}
 	toc.fileSections.end(w)
 
+	if b.hasReferences {
+		toc.fileReferences.start(w)
+		for _, s := range b.docReferences {
+			toc.fileReferences.addItem(w, marshalDocSections(s))
+		}
+		toc.fileReferences.end(w)
+	}
+
+	if len(b.authorNames) > 0 {
+		toc.fileAuthors.start(w)
+		for _, id := range b.docAuthors {
+			w.U32(id)
+		}
+		toc.fileAuthors.end(w)
+
+		toc.authorNames.start(w)
+		for _, a := range b.authorNames {
+			toc.authorNames.addItem(w, []byte(a))
+		}
+		toc.authorNames.end(w)
+	}
+
+	if b.hasCommits {
+		toc.fileKinds.start(w)
+		w.Write(b.docKinds)
+		toc.fileKinds.end(w)
+	}
+
+	if b.hasEncodings {
+		toc.fileEncodings.start(w)
+		for _, e := range b.docEncodings {
+			toc.fileEncodings.addItem(w, []byte(e))
+		}
+		toc.fileEncodings.end(w)
+	}
+
 	writePostings(w, b.contentPostings, &toc.ngramText, &toc.runeOffsets, &toc.postings, &toc.fileEndRunes)
 
 	// names.
@@ -173,11 +220,19 @@ func (b *IndexBuilder) Write(out io.Writer) error {
 		indexTime = time.Now().UTC()
 	}
 
+	minReaderVersion := WriteMinFeatureVersion
+	if b.hasBranchMasksHigh {
+		minReaderVersion = branchMasksHighFeatureVersion
+	}
+	if b.hasCommits {
+		minReaderVersion = fileKindsFeatureVersion
+	}
+
 	if err := b.writeJSON(&IndexMetadata{
 		IndexFormatVersion:    b.indexFormatVersion,
 		IndexTime:             indexTime,
 		IndexFeatureVersion:   b.featureVersion,
-		IndexMinReaderVersion: WriteMinFeatureVersion,
+		IndexMinReaderVersion: minReaderVersion,
 		PlainASCII:            b.contentPostings.isPlainASCII && b.namePostings.isPlainASCII,
 		LanguageMap:           b.languageMap,
 		ZoektVersion:          Version,
@@ -199,6 +254,12 @@ func (b *IndexBuilder) Write(out io.Writer) error {
 		}
 	}
 
+	if len(b.secretFindings) > 0 {
+		if err := b.writeJSON(b.secretFindings, &toc.secretFindings, w); err != nil {
+			return err
+		}
+	}
+
 	toc.ranks.start(w)
 	if err := encodeRanks(w, b.ranks); err != nil {
 		return err